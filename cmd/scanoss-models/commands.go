@@ -0,0 +1,288 @@
+// SPDX-License-Identifier: GPL-2.0-or-later
+/*
+ * Copyright (C) 2026 SCANOSS.COM
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 2 of the License, or
+ * (at your option) any later version.
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/scanoss/go-models/pkg/manifest"
+	"github.com/scanoss/go-models/pkg/models"
+	"github.com/scanoss/go-models/pkg/scanoss"
+	"github.com/scanoss/go-models/pkg/types"
+	purlutils "github.com/scanoss/go-purl-helper/pkg"
+)
+
+// commands returns the list of supported subcommands.
+func commands() []command {
+	return []command{
+		componentCommand(),
+		checkCommand(),
+		versionsCommand(),
+		licenseCommand(),
+		minesCommand(),
+		dbVersionCommand(),
+		reportCommand(),
+	}
+}
+
+// componentCommand resolves the version of a component for an optional requirement.
+func componentCommand() command {
+	var requirement, asOf string
+	return command{
+		name:        "component",
+		usage:       "<purl>",
+		description: "Resolve the version of a component (optionally matching a requirement)",
+		flags: func(fs *flag.FlagSet) {
+			fs.StringVar(&requirement, "requirement", "", "version requirement (e.g. ^1.0.0)")
+			fs.StringVar(&asOf, "as-of", "", "resolve the version as of a date (YYYY-MM-DD)")
+		},
+		run: func(ctx context.Context, client *scanoss.Client, args []string) (result, error) {
+			purl, err := singleArg(args, "purl")
+			if err != nil {
+				return result{}, err
+			}
+			res, err := client.Component.GetComponent(ctx, types.ComponentRequest{Purl: purl, Requirement: requirement, AsOf: asOf})
+			if err != nil {
+				return result{}, err
+			}
+			return result{
+				headers: []string{"PURL", "VERSION", "URL", "LICENSES", "DOWNLOAD_URL", "PACKAGE_HASH", "RELEASE_DATE"},
+				rows:    [][]string{{res.Purl, res.Version, res.URL, licenseNames(res.Licenses), res.DownloadURL, res.PackageHash, res.ReleaseDate}},
+				data:    res,
+			}, nil
+		},
+	}
+}
+
+// checkCommand counts the projects matching a purl.
+func checkCommand() command {
+	return command{
+		name:        "check",
+		usage:       "<purl>",
+		description: "Check how many projects match a purl",
+		run: func(ctx context.Context, client *scanoss.Client, args []string) (result, error) {
+			purl, err := singleArg(args, "purl")
+			if err != nil {
+				return result{}, err
+			}
+			count, err := client.Component.CheckPurl(ctx, purl)
+			if err != nil {
+				return result{}, err
+			}
+			return result{
+				headers: []string{"PURL", "PROJECTS"},
+				rows:    [][]string{{purl, strconv.Itoa(count)}},
+				data:    map[string]any{"purl": purl, "projects": count},
+			}, nil
+		},
+	}
+}
+
+// versionEntry is a single version listed by the versions command.
+type versionEntry struct {
+	Version string `json:"version"`
+	SemVer  string `json:"semver"`
+	License string `json:"license"`
+	Date    string `json:"date,omitempty"`
+}
+
+// versionsCommand lists the known versions of a component.
+func versionsCommand() command {
+	return command{
+		name:        "versions",
+		usage:       "<purl>",
+		description: "List the known versions of a component",
+		run: func(ctx context.Context, client *scanoss.Client, args []string) (result, error) {
+			purl, err := singleArg(args, "purl")
+			if err != nil {
+				return result{}, err
+			}
+			purlType, purlName, err := splitPurl(purl)
+			if err != nil {
+				return result{}, err
+			}
+			allUrls, err := client.Models.AllUrls.GetURLsByPurlNameType(ctx, purlName, purlType)
+			if err != nil {
+				return result{}, err
+			}
+			res := result{headers: []string{"VERSION", "SEMVER", "LICENSE", "DATE"}}
+			versions := []versionEntry{}
+			seen := make(map[string]bool)
+			for _, url := range allUrls {
+				if len(url.Version) == 0 || seen[url.Version] {
+					continue
+				}
+				seen[url.Version] = true
+				versions = append(versions, versionEntry{Version: url.Version, SemVer: url.SemVer, License: url.License, Date: url.Date})
+				res.rows = append(res.rows, []string{url.Version, url.SemVer, url.License, url.Date})
+			}
+			res.data = versions
+			return res, nil
+		},
+	}
+}
+
+// licenseCommand looks up a license by name or ID.
+func licenseCommand() command {
+	return command{
+		name:        "license",
+		usage:       "<name|id>",
+		description: "Look up a license by name or ID",
+		run: func(ctx context.Context, client *scanoss.Client, args []string) (result, error) {
+			arg, err := singleArg(args, "license name or ID")
+			if err != nil {
+				return result{}, err
+			}
+			var license models.License
+			if id, convErr := strconv.ParseInt(arg, 10, 32); convErr == nil {
+				license, err = client.Models.Licenses.GetLicenseByID(ctx, int32(id))
+			} else {
+				license, err = client.Models.Licenses.GetLicenseByName(ctx, arg)
+			}
+			if err != nil {
+				return result{}, err
+			}
+			return result{
+				headers: []string{"ID", "NAME", "SPDX", "IS_SPDX"},
+				rows:    [][]string{{strconv.Itoa(int(license.ID)), license.LicenseName, license.SPDX, strconv.FormatBool(license.IsSpdx)}},
+				data:    license,
+			}, nil
+		},
+	}
+}
+
+// minesCommand lists the mine IDs for a purl type.
+func minesCommand() command {
+	return command{
+		name:        "mines",
+		usage:       "<purl-type>",
+		description: "List the mine IDs for a purl type",
+		run: func(ctx context.Context, client *scanoss.Client, args []string) (result, error) {
+			purlType, err := singleArg(args, "purl type")
+			if err != nil {
+				return result{}, err
+			}
+			mineIDs, err := client.Models.Mines.GetMineIdsByPurlType(ctx, purlType)
+			if err != nil {
+				return result{}, err
+			}
+			res := result{headers: []string{"PURL_TYPE", "MINE_ID"}, data: mineIDs}
+			for _, id := range mineIDs {
+				res.rows = append(res.rows, []string{purlType, strconv.Itoa(int(id))})
+			}
+			return res, nil
+		},
+	}
+}
+
+// dbVersionEntry is a single row listed by the db-version command.
+type dbVersionEntry struct {
+	PackageName   string    `json:"package_name"`
+	SchemaVersion string    `json:"schema_version"`
+	Release       string    `json:"db_release"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// dbVersionCommand lists the db_version rows of the knowledge base.
+func dbVersionCommand() command {
+	return command{
+		name:        "db-version",
+		usage:       "",
+		description: "Show the knowledge base version information",
+		run: func(ctx context.Context, client *scanoss.Client, args []string) (result, error) {
+			if len(args) > 0 {
+				return result{}, fmt.Errorf("unexpected arguments: %v", args)
+			}
+			versions, err := client.Models.DBVersion.GetVersions(ctx)
+			if err != nil {
+				return result{}, err
+			}
+			res := result{headers: []string{"PACKAGE", "SCHEMA", "RELEASE", "CREATED"}}
+			entries := make([]dbVersionEntry, 0, len(versions))
+			for _, v := range versions {
+				entry := dbVersionEntry{PackageName: v.PackageName, SchemaVersion: v.SchemaVersion.String(), Release: v.Release.String(), CreatedAt: v.CreatedAt}
+				entries = append(entries, entry)
+				res.rows = append(res.rows, []string{entry.PackageName, entry.SchemaVersion, entry.Release, entry.CreatedAt.Format(time.RFC3339)})
+			}
+			res.data = entries
+			return res, nil
+		},
+	}
+}
+
+// reportCommand resolves the dependencies of a manifest file.
+func reportCommand() command {
+	return command{
+		name:        "report",
+		usage:       "<manifest>",
+		description: "Report the resolved and latest versions of the dependencies in a manifest file (e.g. package.json)",
+		run: func(ctx context.Context, client *scanoss.Client, args []string) (result, error) {
+			path, err := singleArg(args, "manifest file")
+			if err != nil {
+				return result{}, err
+			}
+			reqs, err := manifest.ParseFile(path)
+			if err != nil {
+				return result{}, err
+			}
+			report, err := client.Report.Report(ctx, reqs)
+			if err != nil {
+				return result{}, err
+			}
+			res := result{headers: []string{"PURL", "REQUIREMENT", "VERSION", "LATEST", "LICENSES", "STATUS"}, data: report}
+			for _, d := range report.Dependencies {
+				res.rows = append(res.rows, []string{d.Purl, d.Requirement, d.Version, d.LatestVersion, licenseNames(d.Licenses), d.Status})
+			}
+			return res, nil
+		},
+	}
+}
+
+// licenseNames returns the names of the given licenses as a comma-separated list.
+func licenseNames(licenses []types.License) string {
+	names := make([]string, 0, len(licenses))
+	for _, l := range licenses {
+		names = append(names, l.Name)
+	}
+	return strings.Join(names, ", ")
+}
+
+// singleArg returns the single positional argument of a command.
+func singleArg(args []string, what string) (string, error) {
+	if len(args) != 1 || len(args[0]) == 0 {
+		return "", fmt.Errorf("please specify a single %v", what)
+	}
+	return args[0], nil
+}
+
+// splitPurl returns the type and bare name of a purl string.
+func splitPurl(purl string) (string, string, error) {
+	p, err := purlutils.PurlFromString(purl)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to parse purl: %w", err)
+	}
+	purlName, err := purlutils.PurlNameFromString(purl)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to extract purl name: %w", err)
+	}
+	return p.Type, purlName, nil
+}
@@ -0,0 +1,187 @@
+// SPDX-License-Identifier: GPL-2.0-or-later
+/*
+ * Copyright (C) 2026 SCANOSS.COM
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 2 of the License, or
+ * (at your option) any later version.
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+// Command scanoss-models queries a SCANOSS knowledge base SQLite file from the command line.
+//
+// Usage:
+//
+//	scanoss-models <command> -db <file> [-format table|json] [-debug] [arguments]
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
+	"github.com/jmoiron/sqlx"
+	"github.com/scanoss/go-models/pkg/scanoss"
+	zlog "github.com/scanoss/zap-logging-helper/pkg/logger"
+	_ "modernc.org/sqlite"
+)
+
+// dbEnvVar is the environment variable used as the default knowledge base file.
+const dbEnvVar = "SCANOSS_DB"
+
+// command describes a CLI subcommand.
+type command struct {
+	name        string
+	usage       string
+	description string
+	flags       func(fs *flag.FlagSet) // Optional registration of command specific flags
+	run         func(ctx context.Context, client *scanoss.Client, args []string) (result, error)
+}
+
+// options holds the flags common to every subcommand.
+type options struct {
+	db     string
+	format string
+	debug  bool
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+// run executes the CLI with the given arguments and returns the process exit code.
+func run(args []string, stdout, stderr io.Writer) int {
+	if len(args) == 0 || args[0] == "-h" || args[0] == "-help" || args[0] == "help" {
+		printUsage(stderr)
+		if len(args) == 0 {
+			return 2
+		}
+		return 0
+	}
+	cmd, ok := findCommand(args[0])
+	if !ok {
+		_, _ = fmt.Fprintf(stderr, "Unknown command: %v\n\n", args[0])
+		printUsage(stderr)
+		return 2
+	}
+	fs := flag.NewFlagSet(cmd.name, flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Usage = func() {
+		_, _ = fmt.Fprintf(stderr, "Usage: scanoss-models %v [flags] %v\n\n%v\n\nFlags:\n", cmd.name, cmd.usage, cmd.description)
+		fs.PrintDefaults()
+	}
+	var opts options
+	fs.StringVar(&opts.db, "db", os.Getenv(dbEnvVar), "path to the knowledge base SQLite file (default $"+dbEnvVar+")")
+	fs.StringVar(&opts.format, "format", formatTable, "output format: table or json")
+	fs.BoolVar(&opts.debug, "debug", false, "enable debug logging")
+	if cmd.flags != nil {
+		cmd.flags(fs)
+	}
+	if err := fs.Parse(args[1:]); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+		return 2
+	}
+	if opts.format != formatTable && opts.format != formatJSON {
+		_, _ = fmt.Fprintf(stderr, "Invalid output format: %v\n", opts.format)
+		return 2
+	}
+	ctx := context.Background()
+	if opts.debug {
+		if err := zlog.NewSugaredDevLogger(); err != nil {
+			_, _ = fmt.Fprintf(stderr, "Failed to setup logging: %v\n", err)
+			return 1
+		}
+		defer zlog.SyncZap()
+		ctx = ctxzap.ToContext(ctx, zlog.L)
+	}
+	db, err := openDB(ctx, opts.db)
+	if err != nil {
+		_, _ = fmt.Fprintf(stderr, "Error: %v\n", err)
+		return 1
+	}
+	defer func() {
+		_ = db.Close()
+	}()
+	res, err := cmd.run(ctx, scanoss.NewContext(ctx, db), fs.Args())
+	if err != nil {
+		_, _ = fmt.Fprintf(stderr, "Error: %v\n", err)
+		return 1
+	}
+	if err = res.write(stdout, opts.format); err != nil {
+		_, _ = fmt.Fprintf(stderr, "Error: failed to write output: %v\n", err)
+		return 1
+	}
+	return 0
+}
+
+// openDB opens the given knowledge base SQLite file in read-only mode.
+func openDB(ctx context.Context, path string) (*sqlx.DB, error) {
+	if len(path) == 0 {
+		return nil, fmt.Errorf("please specify a knowledge base file with -db or $%v", dbEnvVar)
+	}
+	if _, err := os.Stat(path); err != nil {
+		return nil, fmt.Errorf("cannot access knowledge base file: %w", err)
+	}
+	dsn, err := readOnlyDSN(path)
+	if err != nil {
+		return nil, fmt.Errorf("invalid knowledge base file path: %w", err)
+	}
+	db, err := sqlx.Open("sqlite", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open knowledge base: %w", err)
+	}
+	if err = db.PingContext(ctx); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("failed to connect to knowledge base: %w", err)
+	}
+	return db, nil
+}
+
+// readOnlyDSN builds a read-only SQLite URI for the given file, escaping characters such as '?', '#' and '%' in the path.
+func readOnlyDSN(path string) (string, error) {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return "", err
+	}
+	// An empty host gives the file:///path form accepted by SQLite URIs
+	dsn := url.URL{Scheme: "file", Path: filepath.ToSlash(abs), RawQuery: "mode=ro"}
+	if !strings.HasPrefix(dsn.Path, "/") {
+		dsn.Path = "/" + dsn.Path // Windows drive letter paths (e.g. /C:/kb.sqlite)
+	}
+	return dsn.String(), nil
+}
+
+// findCommand returns the subcommand with the given name.
+func findCommand(name string) (command, bool) {
+	for _, cmd := range commands() {
+		if cmd.name == name {
+			return cmd, true
+		}
+	}
+	return command{}, false
+}
+
+// printUsage writes the list of subcommands to the given writer.
+func printUsage(w io.Writer) {
+	_, _ = fmt.Fprintln(w, "Usage: scanoss-models <command> -db <file> [-format table|json] [-debug] [arguments]")
+	_, _ = fmt.Fprintln(w, "\nCommands:")
+	for _, cmd := range commands() {
+		_, _ = fmt.Fprintf(w, "  %-12s %v\n", cmd.name, cmd.description)
+	}
+	_, _ = fmt.Fprintln(w, "\nRun 'scanoss-models <command> -h' for command specific help.")
+}
@@ -0,0 +1,139 @@
+// SPDX-License-Identifier: GPL-2.0-or-later
+/*
+ * Copyright (C) 2026 SCANOSS.COM
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 2 of the License, or
+ * (at your option) any later version.
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/scanoss/go-models/internal/testutils"
+	"github.com/scanoss/go-models/pkg/types"
+)
+
+// setupDBFile creates a knowledge base SQLite file loaded with the mock data.
+func setupDBFile(t *testing.T) string {
+	path := filepath.Join(t.TempDir(), "kb.sqlite")
+	db, err := sqlx.Connect("sqlite", "file:"+path+"?_pragma=synchronous(off)&_pragma=journal_mode(memory)")
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when creating a database file", err)
+	}
+	defer testutils.CloseDB(t, db)
+	testutils.LoadMockSQLData(t, db, "../../internal/testutils/mock")
+	testutils.LoadSQLDataFile(t, db, "../../internal/testutils/mock/db_version.sql")
+	return path
+}
+
+func TestRun(t *testing.T) {
+	dbFile := setupDBFile(t)
+
+	tests := []struct {
+		name     string
+		args     []string
+		wantCode int
+		wantOut  []string
+	}{
+		{name: "no arguments", args: nil, wantCode: 2},
+		{name: "help", args: []string{"help"}, wantCode: 0},
+		{name: "unknown command", args: []string{"rubbish"}, wantCode: 2},
+		{name: "missing db", args: []string{"check", "-db", "", "pkg:gem/tablestyle"}, wantCode: 1},
+		{name: "db does not exist", args: []string{"check", "-db", filepath.Join(t.TempDir(), "none.sqlite"), "pkg:gem/tablestyle"}, wantCode: 1},
+		{name: "invalid format", args: []string{"check", "-db", dbFile, "-format", "xml", "pkg:gem/tablestyle"}, wantCode: 2},
+		{name: "missing purl", args: []string{"component", "-db", dbFile}, wantCode: 1},
+		{
+			name:    "component",
+			args:    []string{"component", "-db", dbFile, "-requirement", "^15.0.0", "pkg:npm/react"},
+			wantOut: []string{"PURL", "pkg:npm/react", "15.7.0"},
+		},
+		{name: "check", args: []string{"check", "-db", dbFile, "pkg:gem/tablestyle"}, wantOut: []string{"PROJECTS", "pkg:gem/tablestyle  1"}},
+		{name: "versions", args: []string{"versions", "-db", dbFile, "pkg:gem/tablestyle"}, wantOut: []string{"0.0.12", "0.0.4", "MIT"}},
+		{name: "license by name", args: []string{"license", "-db", dbFile, "MIT"}, wantOut: []string{"5614", "MIT"}},
+		{name: "license by id", args: []string{"license", "-db", dbFile, "109"}, wantOut: []string{"BSD-3-Clause"}},
+		{name: "mines", args: []string{"mines", "-db", dbFile, "maven"}, wantOut: []string{"maven", "15"}},
+		{name: "db-version", args: []string{"db-version", "-db", dbFile}, wantOut: []string{"base", "2026.01", "2026-01-15T10:30:00Z"}},
+		{
+			name:    "report",
+			args:    []string{"report", "-db", dbFile, "../../pkg/manifest/testdata/Gemfile"},
+			wantOut: []string{"STATUS", "pkg:gem/tablestyle  >=0.0.10, <1.0", "resolved", "not_found"},
+		},
+		{name: "report missing manifest", args: []string{"report", "-db", dbFile, "missing/package.json"}, wantCode: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			code := run(tt.args, &stdout, &stderr)
+			if code != tt.wantCode {
+				t.Fatalf("run() exit code = %v, want %v (stderr: %v)", code, tt.wantCode, stderr.String())
+			}
+			for _, want := range tt.wantOut {
+				if !strings.Contains(stdout.String(), want) {
+					t.Errorf("run() output missing %q:\n%v", want, stdout.String())
+				}
+			}
+		})
+	}
+}
+
+func TestRunSpecialPath(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "kb?release=1#%20")
+	if err := os.Mkdir(dir, 0o755); err != nil {
+		t.Fatalf("failed to create directory: %v", err)
+	}
+	dbFile := filepath.Join(dir, "kb.sqlite")
+	if err := os.Rename(setupDBFile(t), dbFile); err != nil {
+		t.Fatalf("failed to move database file: %v", err)
+	}
+	var stdout, stderr bytes.Buffer
+	if code := run([]string{"check", "-db", dbFile, "pkg:gem/tablestyle"}, &stdout, &stderr); code != 0 {
+		t.Fatalf("run() exit code = %v (stderr: %v)", code, stderr.String())
+	}
+	if !strings.Contains(stdout.String(), "pkg:gem/tablestyle  1") {
+		t.Errorf("run() unexpected output:\n%v", stdout.String())
+	}
+}
+
+func TestRunJSON(t *testing.T) {
+	dbFile := setupDBFile(t)
+
+	var stdout, stderr bytes.Buffer
+	if code := run([]string{"component", "-db", dbFile, "-format", "json", "pkg:npm/electron-updater@4.0.8"}, &stdout, &stderr); code != 0 {
+		t.Fatalf("run() exit code = %v (stderr: %v)", code, stderr.String())
+	}
+	var res types.ComponentResponse
+	if err := json.Unmarshal(stdout.Bytes(), &res); err != nil {
+		t.Fatalf("run() produced invalid JSON: %v\n%v", err, stdout.String())
+	}
+	if res.Version != "4.0.8" {
+		t.Errorf("run() JSON version = %v, want 4.0.8", res.Version)
+	}
+
+	stdout.Reset()
+	if code := run([]string{"versions", "-db", dbFile, "-format", "json", "pkg:gem/tablestyle"}, &stdout, &stderr); code != 0 {
+		t.Fatalf("run() exit code = %v (stderr: %v)", code, stderr.String())
+	}
+	var versions []versionEntry
+	if err := json.Unmarshal(stdout.Bytes(), &versions); err != nil {
+		t.Fatalf("run() produced invalid JSON: %v\n%v", err, stdout.String())
+	}
+	if len(versions) == 0 {
+		t.Errorf("run() expected versions in JSON output")
+	}
+}
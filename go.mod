@@ -8,6 +8,8 @@ require (
 	github.com/jmoiron/sqlx v1.4.0
 	github.com/scanoss/go-purl-helper v0.2.1
 	github.com/scanoss/zap-logging-helper v0.4.0
+	google.golang.org/grpc v1.71.0
+	google.golang.org/protobuf v1.36.5
 	modernc.org/sqlite v1.46.1
 )
 
@@ -27,8 +29,6 @@ require (
 	golang.org/x/sys v0.37.0 // indirect
 	golang.org/x/text v0.23.0 // indirect
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20250303144028-a0af3efb3deb // indirect
-	google.golang.org/grpc v1.71.0 // indirect
-	google.golang.org/protobuf v1.36.5 // indirect
 	modernc.org/libc v1.67.6 // indirect
 	modernc.org/mathutil v1.7.1 // indirect
 	modernc.org/memory v1.11.0 // indirect
@@ -43,6 +43,21 @@ func SqliteSetup(t *testing.T) *sqlx.DB {
 	return db
 }
 
+// SqliteSetupNamed sets up a named in-memory SQLite DB for testing.
+// Unlike SqliteSetup, each name refers to a separate database, allowing tests to use more than one at a time.
+func SqliteSetupNamed(t *testing.T, name string) *sqlx.DB {
+	db, err := sqlx.Connect("sqlite", fmt.Sprintf("file:%s?mode=memory&cache=shared", name))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+
+	if db == nil {
+		t.Fatal("sqlx.Connect() returned nil database\n")
+	}
+
+	return db
+}
+
 // SqliteConn sets up a connection to a test DB.
 func SqliteConn(t *testing.T, ctx context.Context, db *sqlx.DB) *sqlx.Conn {
 	conn, err := db.Connx(ctx) // Get a connection from the pool
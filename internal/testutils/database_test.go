@@ -33,6 +33,22 @@ func TestSqliteSetupAndCleanup(t *testing.T) {
 	CloseDB(t, db)
 }
 
+func TestSqliteSetupNamed(t *testing.T) {
+	first := SqliteSetupNamed(t, "first")
+	defer CloseDB(t, first)
+	second := SqliteSetupNamed(t, "second")
+	defer CloseDB(t, second)
+
+	LoadSQLDataFile(t, first, "mock/mines.sql")
+	var count int
+	if err := second.QueryRow("SELECT count(*) FROM sqlite_master WHERE name = 'mines'").Scan(&count); err != nil {
+		t.Fatalf("failed to query second database: %v", err)
+	}
+	if count != 0 {
+		t.Errorf("SqliteSetupNamed() databases are not independent")
+	}
+}
+
 func TestLoadValidSQLData(t *testing.T) {
 	db := SqliteSetup(t)
 	defer CloseDB(t, db)
@@ -0,0 +1,7 @@
+-- Rows referencing missing versions/licenses (or with NULL columns), as found in partially loaded knowledge bases.
+-- Load on top of the mock data using LoadSQLDataFile.
+insert into all_urls (package_hash, vendor, component, version, date, url, url_hash, mine_id, license, purl_name, version_id, license_id) values ('d4a1e0c1d4a1e0c1d4a1e0c1d4a1e0c1', 'dangling', 'dangling-ids', '1.0.0', '2024-01-10', 'https://registry.npmjs.org/dangling-ids/-/dangling-ids-1.0.0.tgz', 'd4a1e0c1d4a1e0c1d4a1e0c1d4a1e0c2', 2, 'MIT', 'dangling-ids', 13229156, 77777777);
+insert into all_urls (package_hash, vendor, component, version, date, url, url_hash, mine_id, license, purl_name, version_id, license_id) values ('d4a1e0c1d4a1e0c1d4a1e0c1d4a1e0c3', 'dangling', null, '2.0.0', '2024-02-10', 'https://registry.npmjs.org/dangling-ids/-/dangling-ids-2.0.0.tgz', 'd4a1e0c1d4a1e0c1d4a1e0c1d4a1e0c4', 2, null, 'dangling-ids', 77777777, null);
+insert into projects (mine_id, vendor, component, first_version_date, latest_version_date, license, versions, source_vendor, source_component, git_created_at, git_updated_at, git_pushed_at, git_watchers, git_issues, git_forks, git_license, source_mine_id, purl_name, source_purl_name, verified, license_id, git_license_id) values (2, 'dangling', 'dangling-ids', '2024-01-10', '2024-02-10', 'MIT', 2, null, null, null, null, null, null, null, null, null, null, 'dangling-ids', null, null, 77777777, null);
+insert into versions (id, version_name, semver) values (77777778, '7.7.7-dangling', null);
+insert into mines (id, mine_name, purl_type) values (77, null, 'dangling');
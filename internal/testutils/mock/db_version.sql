@@ -8,4 +8,6 @@ CREATE TABLE db_version
 );
 
 INSERT INTO db_version (package_name, schema_version, created_at, db_release)
-VALUES ('base', '1.0.0', '2026-01-15T10:30:00Z', '2026.01');
\ No newline at end of file
+VALUES ('base', '1.0.0', '2026-01-15T10:30:00Z', '2026.01');
+INSERT INTO db_version (package_name, schema_version, created_at, db_release)
+VALUES ('licenses', '1.1.0', '2026-02-03 08:15:00', '2026.02');
\ No newline at end of file
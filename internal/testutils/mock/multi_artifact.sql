@@ -0,0 +1,6 @@
+-- A component publishing several artifacts per version, with differing dates and licenses.
+-- Load on top of the mock data using LoadSQLDataFile.
+insert into all_urls (package_hash, vendor, component, version, date, url, url_hash, mine_id, license, purl_name, version_id, license_id) values ('a27f1c0da27f1c0da27f1c0da27f1c01', 'multi', 'multi-artifact', '1.0.0', '2024-03-01', 'https://registry.npmjs.org/multi-artifact/-/multi-artifact-1.0.0.tgz', 'a27f1c0da27f1c0da27f1c0da27f1c02', 2, 'MIT', 'multi-artifact', 400387, 5614);
+insert into all_urls (package_hash, vendor, component, version, date, url, url_hash, mine_id, license, purl_name, version_id, license_id) values ('a27f1c0da27f1c0da27f1c0da27f1c03', 'multi', 'multi-artifact', '1.0.0', '2024-03-03', 'https://registry.npmjs.org/multi-artifact/-/multi-artifact-1.0.0-unlicensed.tgz', 'a27f1c0da27f1c0da27f1c0da27f1c04', 2, null, 'multi-artifact', 400387, null);
+insert into all_urls (package_hash, vendor, component, version, date, url, url_hash, mine_id, license, purl_name, version_id, license_id) values ('a27f1c0da27f1c0da27f1c0da27f1c05', 'multi', 'multi-artifact', '1.0.0', '2024-03-05', 'https://registry.npmjs.org/multi-artifact/-/multi-artifact-1.0.0-apache.tgz', 'a27f1c0da27f1c0da27f1c0da27f1c06', 2, 'Apache License 2.0', 'multi-artifact', 400387, 850);
+insert into all_urls (package_hash, vendor, component, version, date, url, url_hash, mine_id, license, purl_name, version_id, license_id) values ('a27f1c0da27f1c0da27f1c0da27f1c07', 'multi', 'multi-artifact', '2.0.0', '2024-04-01', 'https://registry.npmjs.org/multi-artifact/-/multi-artifact-2.0.0.tgz', 'a27f1c0da27f1c0da27f1c0da27f1c08', 2, 'MIT', 'multi-artifact', 12798922, 5614);
@@ -0,0 +1,14 @@
+-- Components published under purl types shared by several mines (rpm and maven).
+-- Load on top of the mock data using LoadSQLDataFile.
+insert into versions (id, version_name, semver) values (88888801, '7.76.1', '');
+insert into versions (id, version_name, semver) values (88888802, '7.79.1', '');
+insert into versions (id, version_name, semver) values (88888803, '5.3.9', '');
+insert into versions (id, version_name, semver) values (88888804, '5.3.10', '');
+insert into all_urls (package_hash, vendor, component, version, date, url, url_hash, mine_id, license, purl_name, version_id, license_id) values ('b17e0a11b17e0a11b17e0a11b17e0a01', 'curl', 'curl', '7.76.1', '2021-04-14', 'https://download.fedoraproject.org/pub/fedora/linux/releases/34/Everything/source/tree/Packages/c/curl-7.76.1-2.fc34.src.rpm', 'b17e0a11b17e0a11b17e0a11b17e0a02', 7, 'MIT', 'curl', 88888801, 5614);
+insert into all_urls (package_hash, vendor, component, version, date, url, url_hash, mine_id, license, purl_name, version_id, license_id) values ('b17e0a11b17e0a11b17e0a11b17e0a03', 'curl', 'curl', '8.0.1', '2023-03-20', 'https://rpmfind.net/linux/fedora/linux/development/rawhide/Everything/source/tree/Packages/c/curl-8.0.1-1.fc39.src.rpm', 'b17e0a11b17e0a11b17e0a11b17e0a04', 8, 'MIT', 'curl', 4660486, 5614);
+insert into all_urls (package_hash, vendor, component, version, date, url, url_hash, mine_id, license, purl_name, version_id, license_id) values ('b17e0a11b17e0a11b17e0a11b17e0a05', 'curl', 'curl', '7.79.1', '2021-09-22', 'https://download.opensuse.org/source/tumbleweed/repo/oss/src/curl-7.79.1-1.1.src.rpm', 'b17e0a11b17e0a11b17e0a11b17e0a06', 19, 'MIT', 'curl', 88888802, 5614);
+insert into all_urls (package_hash, vendor, component, version, date, url, url_hash, mine_id, license, purl_name, version_id, license_id) values ('b17e0a11b17e0a11b17e0a11b17e0a07', 'springframework', 'spring-core', '5.3.9', '2021-07-14', 'https://repo1.maven.org/maven2/org/springframework/spring-core/5.3.9/spring-core-5.3.9.jar', 'b17e0a11b17e0a11b17e0a11b17e0a08', 0, 'Apache License 2.0', 'org.springframework/spring-core', 88888803, 850);
+insert into all_urls (package_hash, vendor, component, version, date, url, url_hash, mine_id, license, purl_name, version_id, license_id) values ('b17e0a11b17e0a11b17e0a11b17e0a09', 'springframework', 'spring-core', '5.3.10', '2021-09-15', 'https://repo.spring.io/release/org/springframework/spring-core/5.3.10/spring-core-5.3.10.jar', 'b17e0a11b17e0a11b17e0a11b17e0a10', 15, 'Apache License 2.0', 'org.springframework/spring-core', 88888804, 850);
+insert into all_urls (package_hash, vendor, component, version, date, url, url_hash, mine_id, license, purl_name, version_id, license_id) values ('b17e0a11b17e0a11b17e0a11b17e0a11', 'springframework', 'spring-core', '5.3.9', '2021-07-13', 'https://repo1.maven.org/maven2/org/springframework/spring-core/5.3.9/spring-core-5.3.9-sources.jar', 'b17e0a11b17e0a11b17e0a11b17e0a12', 0, 'Apache License 2.0', 'org.springframework/spring-core', 88888803, 850);
+insert into projects (mine_id, vendor, component, first_version_date, latest_version_date, license, versions, source_vendor, source_component, git_created_at, git_updated_at, git_pushed_at, git_watchers, git_issues, git_forks, git_license, source_mine_id, purl_name, source_purl_name, verified, license_id, git_license_id) values (7, 'curl', 'curl', '2021-04-14', '2021-04-14', 'MIT', 1, null, null, null, null, null, null, null, null, null, null, 'curl', null, null, 5614, null);
+insert into projects (mine_id, vendor, component, first_version_date, latest_version_date, license, versions, source_vendor, source_component, git_created_at, git_updated_at, git_pushed_at, git_watchers, git_issues, git_forks, git_license, source_mine_id, purl_name, source_purl_name, verified, license_id, git_license_id) values (19, 'curl', 'curl', '2021-09-22', '2021-09-22', 'MIT', 1, null, null, null, null, null, null, null, null, null, null, 'curl', null, null, 5614, null);
@@ -0,0 +1,808 @@
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+//
+// Copyright (C) 2026 SCANOSS.COM
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 2 of the License, or
+// (at your option) any later version.
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        (unknown)
+// source: scanoss/models/v1/component.proto
+
+package modelsv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ComponentRequest mirrors types.ComponentRequest.
+type ComponentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Package URL identifying the component.
+	Purl string `protobuf:"bytes,1,opt,name=purl,proto3" json:"purl,omitempty"`
+	// Version constraint (e.g. ">=1.0.0", "^2.0.0").
+	Requirement string `protobuf:"bytes,2,opt,name=requirement,proto3" json:"requirement,omitempty"`
+	// Optional date (YYYY-MM-DD) to resolve the version as of, excluding later releases.
+	AsOf          string `protobuf:"bytes,3,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ComponentRequest) Reset() {
+	*x = ComponentRequest{}
+	mi := &file_scanoss_models_v1_component_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ComponentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ComponentRequest) ProtoMessage() {}
+
+func (x *ComponentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scanoss_models_v1_component_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ComponentRequest.ProtoReflect.Descriptor instead.
+func (*ComponentRequest) Descriptor() ([]byte, []int) {
+	return file_scanoss_models_v1_component_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ComponentRequest) GetPurl() string {
+	if x != nil {
+		return x.Purl
+	}
+	return ""
+}
+
+func (x *ComponentRequest) GetRequirement() string {
+	if x != nil {
+		return x.Requirement
+	}
+	return ""
+}
+
+func (x *ComponentRequest) GetAsOf() string {
+	if x != nil {
+		return x.AsOf
+	}
+	return ""
+}
+
+// ComponentResponse mirrors types.ComponentResponse.
+type ComponentResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Package URL of the component.
+	Purl string `protobuf:"bytes,1,opt,name=purl,proto3" json:"purl,omitempty"`
+	// Resolved component version.
+	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// Project URL of the component (if known).
+	Url string `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	// Licenses declared for the resolved version.
+	Licenses []*License `protobuf:"bytes,4,rep,name=licenses,proto3" json:"licenses,omitempty"`
+	// Vendor of the component (if known).
+	Vendor string `protobuf:"bytes,5,opt,name=vendor,proto3" json:"vendor,omitempty"`
+	// Download URL of the resolved version's package archive.
+	DownloadUrl string `protobuf:"bytes,6,opt,name=download_url,json=downloadUrl,proto3" json:"download_url,omitempty"`
+	// MD5 hash of the download URL.
+	UrlHash string `protobuf:"bytes,7,opt,name=url_hash,json=urlHash,proto3" json:"url_hash,omitempty"`
+	// MD5 hash of the package archive.
+	PackageHash string `protobuf:"bytes,8,opt,name=package_hash,json=packageHash,proto3" json:"package_hash,omitempty"`
+	// Release date of the resolved version (YYYY-MM-DD), if known.
+	ReleaseDate   string `protobuf:"bytes,9,opt,name=release_date,json=releaseDate,proto3" json:"release_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ComponentResponse) Reset() {
+	*x = ComponentResponse{}
+	mi := &file_scanoss_models_v1_component_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ComponentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ComponentResponse) ProtoMessage() {}
+
+func (x *ComponentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_scanoss_models_v1_component_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ComponentResponse.ProtoReflect.Descriptor instead.
+func (*ComponentResponse) Descriptor() ([]byte, []int) {
+	return file_scanoss_models_v1_component_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ComponentResponse) GetPurl() string {
+	if x != nil {
+		return x.Purl
+	}
+	return ""
+}
+
+func (x *ComponentResponse) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *ComponentResponse) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *ComponentResponse) GetLicenses() []*License {
+	if x != nil {
+		return x.Licenses
+	}
+	return nil
+}
+
+func (x *ComponentResponse) GetVendor() string {
+	if x != nil {
+		return x.Vendor
+	}
+	return ""
+}
+
+func (x *ComponentResponse) GetDownloadUrl() string {
+	if x != nil {
+		return x.DownloadUrl
+	}
+	return ""
+}
+
+func (x *ComponentResponse) GetUrlHash() string {
+	if x != nil {
+		return x.UrlHash
+	}
+	return ""
+}
+
+func (x *ComponentResponse) GetPackageHash() string {
+	if x != nil {
+		return x.PackageHash
+	}
+	return ""
+}
+
+func (x *ComponentResponse) GetReleaseDate() string {
+	if x != nil {
+		return x.ReleaseDate
+	}
+	return ""
+}
+
+// License mirrors types.License.
+type License struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// License identifier in the knowledge base.
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// License name.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// SPDX identifier of the license (if known).
+	SpdxId string `protobuf:"bytes,3,opt,name=spdx_id,json=spdxId,proto3" json:"spdx_id,omitempty"`
+	// Whether the license has a valid SPDX identifier.
+	IsSpdx        bool `protobuf:"varint,4,opt,name=is_spdx,json=isSpdx,proto3" json:"is_spdx,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *License) Reset() {
+	*x = License{}
+	mi := &file_scanoss_models_v1_component_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *License) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*License) ProtoMessage() {}
+
+func (x *License) ProtoReflect() protoreflect.Message {
+	mi := &file_scanoss_models_v1_component_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use License.ProtoReflect.Descriptor instead.
+func (*License) Descriptor() ([]byte, []int) {
+	return file_scanoss_models_v1_component_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *License) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *License) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *License) GetSpdxId() string {
+	if x != nil {
+		return x.SpdxId
+	}
+	return ""
+}
+
+func (x *License) GetIsSpdx() bool {
+	if x != nil {
+		return x.IsSpdx
+	}
+	return false
+}
+
+// ComponentsRequest holds a batch of component requests.
+type ComponentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Components    []*ComponentRequest    `protobuf:"bytes,1,rep,name=components,proto3" json:"components,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ComponentsRequest) Reset() {
+	*x = ComponentsRequest{}
+	mi := &file_scanoss_models_v1_component_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ComponentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ComponentsRequest) ProtoMessage() {}
+
+func (x *ComponentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scanoss_models_v1_component_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ComponentsRequest.ProtoReflect.Descriptor instead.
+func (*ComponentsRequest) Descriptor() ([]byte, []int) {
+	return file_scanoss_models_v1_component_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ComponentsRequest) GetComponents() []*ComponentRequest {
+	if x != nil {
+		return x.Components
+	}
+	return nil
+}
+
+// ComponentsResponse holds the results of a batch of component requests, in request order.
+type ComponentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*ComponentResult     `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ComponentsResponse) Reset() {
+	*x = ComponentsResponse{}
+	mi := &file_scanoss_models_v1_component_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ComponentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ComponentsResponse) ProtoMessage() {}
+
+func (x *ComponentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_scanoss_models_v1_component_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ComponentsResponse.ProtoReflect.Descriptor instead.
+func (*ComponentsResponse) Descriptor() ([]byte, []int) {
+	return file_scanoss_models_v1_component_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ComponentsResponse) GetResults() []*ComponentResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// ComponentResult holds the outcome of resolving a single component request within a batch or stream.
+type ComponentResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The request being resolved.
+	Request *ComponentRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	// Types that are valid to be assigned to Result:
+	//
+	//	*ComponentResult_Response
+	//	*ComponentResult_Error
+	Result        isComponentResult_Result `protobuf_oneof:"result"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ComponentResult) Reset() {
+	*x = ComponentResult{}
+	mi := &file_scanoss_models_v1_component_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ComponentResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ComponentResult) ProtoMessage() {}
+
+func (x *ComponentResult) ProtoReflect() protoreflect.Message {
+	mi := &file_scanoss_models_v1_component_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ComponentResult.ProtoReflect.Descriptor instead.
+func (*ComponentResult) Descriptor() ([]byte, []int) {
+	return file_scanoss_models_v1_component_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ComponentResult) GetRequest() *ComponentRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *ComponentResult) GetResult() isComponentResult_Result {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *ComponentResult) GetResponse() *ComponentResponse {
+	if x != nil {
+		if x, ok := x.Result.(*ComponentResult_Response); ok {
+			return x.Response
+		}
+	}
+	return nil
+}
+
+func (x *ComponentResult) GetError() *Error {
+	if x != nil {
+		if x, ok := x.Result.(*ComponentResult_Error); ok {
+			return x.Error
+		}
+	}
+	return nil
+}
+
+type isComponentResult_Result interface {
+	isComponentResult_Result()
+}
+
+type ComponentResult_Response struct {
+	// The resolved component, if successful.
+	Response *ComponentResponse `protobuf:"bytes,2,opt,name=response,proto3,oneof"`
+}
+
+type ComponentResult_Error struct {
+	// The reason the request failed.
+	Error *Error `protobuf:"bytes,3,opt,name=error,proto3,oneof"`
+}
+
+func (*ComponentResult_Response) isComponentResult_Result() {}
+
+func (*ComponentResult_Error) isComponentResult_Result() {}
+
+// Error describes a failed request.
+type Error struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// gRPC status code (see google.golang.org/grpc/codes).
+	Code int32 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	// Human-readable description of the error.
+	Message       string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Error) Reset() {
+	*x = Error{}
+	mi := &file_scanoss_models_v1_component_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Error) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Error) ProtoMessage() {}
+
+func (x *Error) ProtoReflect() protoreflect.Message {
+	mi := &file_scanoss_models_v1_component_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Error.ProtoReflect.Descriptor instead.
+func (*Error) Descriptor() ([]byte, []int) {
+	return file_scanoss_models_v1_component_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Error) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *Error) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// CheckPurlRequest holds the purl to check.
+type CheckPurlRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Purl          string                 `protobuf:"bytes,1,opt,name=purl,proto3" json:"purl,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckPurlRequest) Reset() {
+	*x = CheckPurlRequest{}
+	mi := &file_scanoss_models_v1_component_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckPurlRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckPurlRequest) ProtoMessage() {}
+
+func (x *CheckPurlRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scanoss_models_v1_component_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckPurlRequest.ProtoReflect.Descriptor instead.
+func (*CheckPurlRequest) Descriptor() ([]byte, []int) {
+	return file_scanoss_models_v1_component_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CheckPurlRequest) GetPurl() string {
+	if x != nil {
+		return x.Purl
+	}
+	return ""
+}
+
+// CheckPurlResponse mirrors types.CheckPurlResponse.
+type CheckPurlResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Package URL that was checked.
+	Purl string `protobuf:"bytes,1,opt,name=purl,proto3" json:"purl,omitempty"`
+	// Number of projects matching the purl.
+	Projects      int32 `protobuf:"varint,2,opt,name=projects,proto3" json:"projects,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckPurlResponse) Reset() {
+	*x = CheckPurlResponse{}
+	mi := &file_scanoss_models_v1_component_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckPurlResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckPurlResponse) ProtoMessage() {}
+
+func (x *CheckPurlResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_scanoss_models_v1_component_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckPurlResponse.ProtoReflect.Descriptor instead.
+func (*CheckPurlResponse) Descriptor() ([]byte, []int) {
+	return file_scanoss_models_v1_component_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CheckPurlResponse) GetPurl() string {
+	if x != nil {
+		return x.Purl
+	}
+	return ""
+}
+
+func (x *CheckPurlResponse) GetProjects() int32 {
+	if x != nil {
+		return x.Projects
+	}
+	return 0
+}
+
+var File_scanoss_models_v1_component_proto protoreflect.FileDescriptor
+
+var file_scanoss_models_v1_component_proto_rawDesc = string([]byte{
+	0x0a, 0x21, 0x73, 0x63, 0x61, 0x6e, 0x6f, 0x73, 0x73, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
+	0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x11, 0x73, 0x63, 0x61, 0x6e, 0x6f, 0x73, 0x73, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x73, 0x2e, 0x76, 0x31, 0x22, 0x5d, 0x0a, 0x10, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x75,
+	0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x75, 0x72, 0x6c, 0x12, 0x20,
+	0x0a, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x13, 0x0a, 0x05, 0x61, 0x73, 0x5f, 0x6f, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x61, 0x73, 0x4f, 0x66, 0x22, 0xa7, 0x02, 0x0a, 0x11, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70,
+	0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x75, 0x72, 0x6c, 0x12,
+	0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x36, 0x0a, 0x08, 0x6c,
+	0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x73, 0x63, 0x61, 0x6e, 0x6f, 0x73, 0x73, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x6c, 0x69, 0x63, 0x65, 0x6e,
+	0x73, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x64,
+	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x55, 0x72, 0x6c, 0x12, 0x19,
+	0x0a, 0x08, 0x75, 0x72, 0x6c, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x75, 0x72, 0x6c, 0x48, 0x61, 0x73, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x21, 0x0a, 0x0c,
+	0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x61, 0x74, 0x65, 0x22,
+	0x5f, 0x0a, 0x07, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x17,
+	0x0a, 0x07, 0x73, 0x70, 0x64, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x70, 0x64, 0x78, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x73, 0x5f, 0x73, 0x70,
+	0x64, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x73, 0x53, 0x70, 0x64, 0x78,
+	0x22, 0x58, 0x0a, 0x11, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x43, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65,
+	0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73, 0x63, 0x61, 0x6e,
+	0x6f, 0x73, 0x73, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f,
+	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a,
+	0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x52, 0x0a, 0x12, 0x43, 0x6f,
+	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3c, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x73, 0x63, 0x61, 0x6e, 0x6f, 0x73, 0x73, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0xd0,
+	0x01, 0x0a, 0x0f, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x3d, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73, 0x63, 0x61, 0x6e, 0x6f, 0x73, 0x73, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x42, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x63, 0x61, 0x6e, 0x6f, 0x73, 0x73, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x08, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x63, 0x61, 0x6e, 0x6f, 0x73, 0x73, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x22, 0x35, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x26, 0x0a, 0x10, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x50, 0x75, 0x72, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x70, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x75, 0x72, 0x6c,
+	0x22, 0x43, 0x0a, 0x11, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x50, 0x75, 0x72, 0x6c, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x75, 0x72, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x73, 0x32, 0x84, 0x03, 0x0a, 0x10, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e,
+	0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x59, 0x0a, 0x0c, 0x47, 0x65,
+	0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x23, 0x2e, 0x73, 0x63, 0x61,
+	0x6e, 0x6f, 0x73, 0x73, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x24, 0x2e, 0x73, 0x63, 0x61, 0x6e, 0x6f, 0x73, 0x73, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70,
+	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x24, 0x2e, 0x73, 0x63, 0x61, 0x6e, 0x6f, 0x73, 0x73,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x73,
+	0x63, 0x61, 0x6e, 0x6f, 0x73, 0x73, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x10, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x43, 0x6f, 0x6d,
+	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x23, 0x2e, 0x73, 0x63, 0x61, 0x6e, 0x6f, 0x73,
+	0x73, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70,
+	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x73,
+	0x63, 0x61, 0x6e, 0x6f, 0x73, 0x73, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x28, 0x01, 0x30, 0x01, 0x12, 0x56, 0x0a, 0x09, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x50, 0x75, 0x72,
+	0x6c, 0x12, 0x23, 0x2e, 0x73, 0x63, 0x61, 0x6e, 0x6f, 0x73, 0x73, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x50, 0x75, 0x72, 0x6c, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x73, 0x63, 0x61, 0x6e, 0x6f, 0x73, 0x73,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x50, 0x75, 0x72, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x38, 0x5a, 0x36,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x63, 0x61, 0x6e, 0x6f,
+	0x73, 0x73, 0x2f, 0x67, 0x6f, 0x2d, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2f, 0x70, 0x6b, 0x67,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x76, 0x31, 0x3b, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x73, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_scanoss_models_v1_component_proto_rawDescOnce sync.Once
+	file_scanoss_models_v1_component_proto_rawDescData []byte
+)
+
+func file_scanoss_models_v1_component_proto_rawDescGZIP() []byte {
+	file_scanoss_models_v1_component_proto_rawDescOnce.Do(func() {
+		file_scanoss_models_v1_component_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_scanoss_models_v1_component_proto_rawDesc), len(file_scanoss_models_v1_component_proto_rawDesc)))
+	})
+	return file_scanoss_models_v1_component_proto_rawDescData
+}
+
+var file_scanoss_models_v1_component_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_scanoss_models_v1_component_proto_goTypes = []any{
+	(*ComponentRequest)(nil),   // 0: scanoss.models.v1.ComponentRequest
+	(*ComponentResponse)(nil),  // 1: scanoss.models.v1.ComponentResponse
+	(*License)(nil),            // 2: scanoss.models.v1.License
+	(*ComponentsRequest)(nil),  // 3: scanoss.models.v1.ComponentsRequest
+	(*ComponentsResponse)(nil), // 4: scanoss.models.v1.ComponentsResponse
+	(*ComponentResult)(nil),    // 5: scanoss.models.v1.ComponentResult
+	(*Error)(nil),              // 6: scanoss.models.v1.Error
+	(*CheckPurlRequest)(nil),   // 7: scanoss.models.v1.CheckPurlRequest
+	(*CheckPurlResponse)(nil),  // 8: scanoss.models.v1.CheckPurlResponse
+}
+var file_scanoss_models_v1_component_proto_depIdxs = []int32{
+	2,  // 0: scanoss.models.v1.ComponentResponse.licenses:type_name -> scanoss.models.v1.License
+	0,  // 1: scanoss.models.v1.ComponentsRequest.components:type_name -> scanoss.models.v1.ComponentRequest
+	5,  // 2: scanoss.models.v1.ComponentsResponse.results:type_name -> scanoss.models.v1.ComponentResult
+	0,  // 3: scanoss.models.v1.ComponentResult.request:type_name -> scanoss.models.v1.ComponentRequest
+	1,  // 4: scanoss.models.v1.ComponentResult.response:type_name -> scanoss.models.v1.ComponentResponse
+	6,  // 5: scanoss.models.v1.ComponentResult.error:type_name -> scanoss.models.v1.Error
+	0,  // 6: scanoss.models.v1.ComponentService.GetComponent:input_type -> scanoss.models.v1.ComponentRequest
+	3,  // 7: scanoss.models.v1.ComponentService.GetComponents:input_type -> scanoss.models.v1.ComponentsRequest
+	0,  // 8: scanoss.models.v1.ComponentService.StreamComponents:input_type -> scanoss.models.v1.ComponentRequest
+	7,  // 9: scanoss.models.v1.ComponentService.CheckPurl:input_type -> scanoss.models.v1.CheckPurlRequest
+	1,  // 10: scanoss.models.v1.ComponentService.GetComponent:output_type -> scanoss.models.v1.ComponentResponse
+	4,  // 11: scanoss.models.v1.ComponentService.GetComponents:output_type -> scanoss.models.v1.ComponentsResponse
+	5,  // 12: scanoss.models.v1.ComponentService.StreamComponents:output_type -> scanoss.models.v1.ComponentResult
+	8,  // 13: scanoss.models.v1.ComponentService.CheckPurl:output_type -> scanoss.models.v1.CheckPurlResponse
+	10, // [10:14] is the sub-list for method output_type
+	6,  // [6:10] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_scanoss_models_v1_component_proto_init() }
+func file_scanoss_models_v1_component_proto_init() {
+	if File_scanoss_models_v1_component_proto != nil {
+		return
+	}
+	file_scanoss_models_v1_component_proto_msgTypes[5].OneofWrappers = []any{
+		(*ComponentResult_Response)(nil),
+		(*ComponentResult_Error)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_scanoss_models_v1_component_proto_rawDesc), len(file_scanoss_models_v1_component_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_scanoss_models_v1_component_proto_goTypes,
+		DependencyIndexes: file_scanoss_models_v1_component_proto_depIdxs,
+		MessageInfos:      file_scanoss_models_v1_component_proto_msgTypes,
+	}.Build()
+	File_scanoss_models_v1_component_proto = out.File
+	file_scanoss_models_v1_component_proto_goTypes = nil
+	file_scanoss_models_v1_component_proto_depIdxs = nil
+}
@@ -0,0 +1,258 @@
+// SPDX-License-Identifier: GPL-2.0-or-later
+
+//
+// Copyright (C) 2026 SCANOSS.COM
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 2 of the License, or
+// (at your option) any later version.
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: scanoss/models/v1/component.proto
+
+package modelsv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ComponentService_GetComponent_FullMethodName     = "/scanoss.models.v1.ComponentService/GetComponent"
+	ComponentService_GetComponents_FullMethodName    = "/scanoss.models.v1.ComponentService/GetComponents"
+	ComponentService_StreamComponents_FullMethodName = "/scanoss.models.v1.ComponentService/StreamComponents"
+	ComponentService_CheckPurl_FullMethodName        = "/scanoss.models.v1.ComponentService/CheckPurl"
+)
+
+// ComponentServiceClient is the client API for ComponentService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ComponentService resolves components against a SCANOSS knowledge base.
+type ComponentServiceClient interface {
+	// GetComponent resolves the version of a single component.
+	GetComponent(ctx context.Context, in *ComponentRequest, opts ...grpc.CallOption) (*ComponentResponse, error)
+	// GetComponents resolves the versions of a batch of components.
+	GetComponents(ctx context.Context, in *ComponentsRequest, opts ...grpc.CallOption) (*ComponentsResponse, error)
+	// StreamComponents resolves each component request as it is received.
+	StreamComponents(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ComponentRequest, ComponentResult], error)
+	// CheckPurl returns the number of projects matching a purl.
+	CheckPurl(ctx context.Context, in *CheckPurlRequest, opts ...grpc.CallOption) (*CheckPurlResponse, error)
+}
+
+type componentServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewComponentServiceClient(cc grpc.ClientConnInterface) ComponentServiceClient {
+	return &componentServiceClient{cc}
+}
+
+func (c *componentServiceClient) GetComponent(ctx context.Context, in *ComponentRequest, opts ...grpc.CallOption) (*ComponentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ComponentResponse)
+	err := c.cc.Invoke(ctx, ComponentService_GetComponent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *componentServiceClient) GetComponents(ctx context.Context, in *ComponentsRequest, opts ...grpc.CallOption) (*ComponentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ComponentsResponse)
+	err := c.cc.Invoke(ctx, ComponentService_GetComponents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *componentServiceClient) StreamComponents(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ComponentRequest, ComponentResult], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ComponentService_ServiceDesc.Streams[0], ComponentService_StreamComponents_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ComponentRequest, ComponentResult]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ComponentService_StreamComponentsClient = grpc.BidiStreamingClient[ComponentRequest, ComponentResult]
+
+func (c *componentServiceClient) CheckPurl(ctx context.Context, in *CheckPurlRequest, opts ...grpc.CallOption) (*CheckPurlResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckPurlResponse)
+	err := c.cc.Invoke(ctx, ComponentService_CheckPurl_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ComponentServiceServer is the server API for ComponentService service.
+// All implementations must embed UnimplementedComponentServiceServer
+// for forward compatibility.
+//
+// ComponentService resolves components against a SCANOSS knowledge base.
+type ComponentServiceServer interface {
+	// GetComponent resolves the version of a single component.
+	GetComponent(context.Context, *ComponentRequest) (*ComponentResponse, error)
+	// GetComponents resolves the versions of a batch of components.
+	GetComponents(context.Context, *ComponentsRequest) (*ComponentsResponse, error)
+	// StreamComponents resolves each component request as it is received.
+	StreamComponents(grpc.BidiStreamingServer[ComponentRequest, ComponentResult]) error
+	// CheckPurl returns the number of projects matching a purl.
+	CheckPurl(context.Context, *CheckPurlRequest) (*CheckPurlResponse, error)
+	mustEmbedUnimplementedComponentServiceServer()
+}
+
+// UnimplementedComponentServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedComponentServiceServer struct{}
+
+func (UnimplementedComponentServiceServer) GetComponent(context.Context, *ComponentRequest) (*ComponentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetComponent not implemented")
+}
+func (UnimplementedComponentServiceServer) GetComponents(context.Context, *ComponentsRequest) (*ComponentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetComponents not implemented")
+}
+func (UnimplementedComponentServiceServer) StreamComponents(grpc.BidiStreamingServer[ComponentRequest, ComponentResult]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamComponents not implemented")
+}
+func (UnimplementedComponentServiceServer) CheckPurl(context.Context, *CheckPurlRequest) (*CheckPurlResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckPurl not implemented")
+}
+func (UnimplementedComponentServiceServer) mustEmbedUnimplementedComponentServiceServer() {}
+func (UnimplementedComponentServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeComponentServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ComponentServiceServer will
+// result in compilation errors.
+type UnsafeComponentServiceServer interface {
+	mustEmbedUnimplementedComponentServiceServer()
+}
+
+func RegisterComponentServiceServer(s grpc.ServiceRegistrar, srv ComponentServiceServer) {
+	// If the following call pancis, it indicates UnimplementedComponentServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ComponentService_ServiceDesc, srv)
+}
+
+func _ComponentService_GetComponent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ComponentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ComponentServiceServer).GetComponent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ComponentService_GetComponent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ComponentServiceServer).GetComponent(ctx, req.(*ComponentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ComponentService_GetComponents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ComponentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ComponentServiceServer).GetComponents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ComponentService_GetComponents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ComponentServiceServer).GetComponents(ctx, req.(*ComponentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ComponentService_StreamComponents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ComponentServiceServer).StreamComponents(&grpc.GenericServerStream[ComponentRequest, ComponentResult]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ComponentService_StreamComponentsServer = grpc.BidiStreamingServer[ComponentRequest, ComponentResult]
+
+func _ComponentService_CheckPurl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckPurlRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ComponentServiceServer).CheckPurl(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ComponentService_CheckPurl_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ComponentServiceServer).CheckPurl(ctx, req.(*CheckPurlRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ComponentService_ServiceDesc is the grpc.ServiceDesc for ComponentService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ComponentService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "scanoss.models.v1.ComponentService",
+	HandlerType: (*ComponentServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetComponent",
+			Handler:    _ComponentService_GetComponent_Handler,
+		},
+		{
+			MethodName: "GetComponents",
+			Handler:    _ComponentService_GetComponents_Handler,
+		},
+		{
+			MethodName: "CheckPurl",
+			Handler:    _ComponentService_CheckPurl_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamComponents",
+			Handler:       _ComponentService_StreamComponents_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "scanoss/models/v1/component.proto",
+}
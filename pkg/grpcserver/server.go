@@ -0,0 +1,185 @@
+// SPDX-License-Identifier: GPL-2.0-or-later
+/*
+ * Copyright (C) 2026 SCANOSS.COM
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 2 of the License, or
+ * (at your option) any later version.
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+// Package grpcserver adapts the SCANOSS ComponentService to the scanoss.models.v1 gRPC service definition.
+//
+// Register the adapter on a grpc.Server using Register. Request logging is picked up from
+// the ctxzap logger in the request context (e.g. from the go-grpc-middleware zap interceptors).
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
+	"github.com/scanoss/go-models/pkg/api/modelsv1"
+	"github.com/scanoss/go-models/pkg/models"
+	"github.com/scanoss/go-models/pkg/services"
+	"github.com/scanoss/go-models/pkg/types"
+	purlutils "github.com/scanoss/go-purl-helper/pkg"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MaxBatchSize is the maximum number of components accepted in a single GetComponents request.
+const MaxBatchSize = 1000
+
+// Server implements modelsv1.ComponentServiceServer on top of services.ComponentService.
+type Server struct {
+	modelsv1.UnimplementedComponentServiceServer
+	component *services.ComponentService
+}
+
+// New creates a new gRPC adapter for the given component service.
+func New(component *services.ComponentService) *Server {
+	return &Server{component: component}
+}
+
+// Register creates a new adapter for the given component service and registers it on the gRPC server.
+func Register(s grpc.ServiceRegistrar, component *services.ComponentService) *Server {
+	srv := New(component)
+	modelsv1.RegisterComponentServiceServer(s, srv)
+	return srv
+}
+
+// GetComponent resolves the version of a single component.
+func (s *Server) GetComponent(ctx context.Context, req *modelsv1.ComponentRequest) (*modelsv1.ComponentResponse, error) {
+	res, err := s.getComponent(ctx, req)
+	if err != nil {
+		return nil, toStatus(ctx, err).Err()
+	}
+	return res, nil
+}
+
+// GetComponents resolves the versions of a batch of components.
+// Individual failures are reported per result, rather than failing the whole batch.
+func (s *Server) GetComponents(ctx context.Context, req *modelsv1.ComponentsRequest) (*modelsv1.ComponentsResponse, error) {
+	if len(req.GetComponents()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "please specify at least one component")
+	}
+	if len(req.GetComponents()) > MaxBatchSize {
+		return nil, status.Errorf(codes.InvalidArgument, "too many components in batch: %d (max %d)", len(req.GetComponents()), MaxBatchSize)
+	}
+	results := make([]*modelsv1.ComponentResult, 0, len(req.GetComponents()))
+	for _, c := range req.GetComponents() {
+		if err := ctx.Err(); err != nil {
+			return nil, status.FromContextError(err).Err()
+		}
+		results = append(results, s.componentResult(ctx, c))
+	}
+	return &modelsv1.ComponentsResponse{Results: results}, nil
+}
+
+// StreamComponents resolves each component request as it is received, sending back a result for each.
+func (s *Server) StreamComponents(stream grpc.BidiStreamingServer[modelsv1.ComponentRequest, modelsv1.ComponentResult]) error {
+	ctx := stream.Context()
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err = stream.Send(s.componentResult(ctx, req)); err != nil {
+			return err
+		}
+	}
+}
+
+// CheckPurl returns the number of projects matching a purl.
+func (s *Server) CheckPurl(ctx context.Context, req *modelsv1.CheckPurlRequest) (*modelsv1.CheckPurlResponse, error) {
+	if err := validatePurl(req.GetPurl()); err != nil {
+		return nil, toStatus(ctx, err).Err()
+	}
+	count, err := s.component.CheckPurl(ctx, req.GetPurl())
+	if err != nil {
+		return nil, toStatus(ctx, err).Err()
+	}
+	return &modelsv1.CheckPurlResponse{Purl: req.GetPurl(), Projects: int32(count)}, nil //nolint:gosec // project counts fit in an int32
+}
+
+// getComponent validates and resolves a single component request.
+func (s *Server) getComponent(ctx context.Context, req *modelsv1.ComponentRequest) (*modelsv1.ComponentResponse, error) {
+	if err := validatePurl(req.GetPurl()); err != nil {
+		return nil, err
+	}
+	res, err := s.component.GetComponent(ctx, types.ComponentRequest{Purl: req.GetPurl(), Requirement: req.GetRequirement(), AsOf: req.GetAsOf()})
+	if err != nil {
+		return nil, err
+	}
+	licenses := make([]*modelsv1.License, 0, len(res.Licenses))
+	for _, l := range res.Licenses {
+		licenses = append(licenses, &modelsv1.License{Id: l.ID, Name: l.Name, SpdxId: l.SPDXID, IsSpdx: l.IsSpdx})
+	}
+	return &modelsv1.ComponentResponse{
+		Purl:        res.Purl,
+		Version:     res.Version,
+		Url:         res.URL,
+		Licenses:    licenses,
+		Vendor:      res.Vendor,
+		DownloadUrl: res.DownloadURL,
+		UrlHash:     res.URLHash,
+		PackageHash: res.PackageHash,
+		ReleaseDate: res.ReleaseDate,
+	}, nil
+}
+
+// componentResult resolves a single component request, capturing any failure in the result.
+func (s *Server) componentResult(ctx context.Context, req *modelsv1.ComponentRequest) *modelsv1.ComponentResult {
+	res, err := s.getComponent(ctx, req)
+	if err != nil {
+		st := toStatus(ctx, err)
+		return &modelsv1.ComponentResult{
+			Request: req,
+			Result:  &modelsv1.ComponentResult_Error{Error: &modelsv1.Error{Code: int32(st.Code()), Message: st.Message()}}, //nolint:gosec // gRPC codes fit in an int32
+		}
+	}
+	return &modelsv1.ComponentResult{Request: req, Result: &modelsv1.ComponentResult_Response{Response: res}}
+}
+
+// validatePurl checks that the given string is a valid purl with a name.
+func validatePurl(purl string) error {
+	if len(purl) == 0 {
+		return fmt.Errorf("%w: please specify a valid purl", models.ErrInvalidInput)
+	}
+	if _, err := purlutils.PurlFromString(purl); err != nil {
+		return fmt.Errorf("%w: failed to parse purl: %w", models.ErrInvalidInput, err)
+	}
+	if _, err := purlutils.PurlNameFromString(purl); err != nil {
+		return fmt.Errorf("%w: failed to extract purl name: %w", models.ErrInvalidInput, err)
+	}
+	return nil
+}
+
+// toStatus maps an error to a gRPC status.
+func toStatus(ctx context.Context, err error) *status.Status {
+	switch {
+	case errors.Is(err, models.ErrInvalidInput):
+		return status.New(codes.InvalidArgument, err.Error())
+	case errors.Is(err, models.ErrNotFound), errors.Is(err, models.ErrNoVersionMatch):
+		return status.New(codes.NotFound, err.Error())
+	case errors.Is(err, models.ErrSchemaIncompatible), errors.Is(err, models.ErrTableNotFound):
+		return status.New(codes.Unavailable, err.Error())
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return status.FromContextError(err)
+	}
+	ctxzap.Extract(ctx).Sugar().Errorf("Component request failed: %v", err)
+	return status.New(codes.Internal, err.Error())
+}
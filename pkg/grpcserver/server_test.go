@@ -0,0 +1,212 @@
+// SPDX-License-Identifier: GPL-2.0-or-later
+/*
+ * Copyright (C) 2026 SCANOSS.COM
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 2 of the License, or
+ * (at your option) any later version.
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/scanoss/go-models/internal/testutils"
+	"github.com/scanoss/go-models/pkg/api/modelsv1"
+	"github.com/scanoss/go-models/pkg/models"
+	"github.com/scanoss/go-models/pkg/services"
+	zlog "github.com/scanoss/zap-logging-helper/pkg/logger"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// setupClient starts an in-process gRPC server backed by the mock database and returns a client connected to it.
+func setupClient(t *testing.T) modelsv1.ComponentServiceClient {
+	t.Helper()
+	db := testutils.SqliteSetup(t) // Setup SQL Lite DB
+	t.Cleanup(func() { testutils.CloseDB(t, db) })
+	testutils.LoadMockSQLData(t, db, "../../internal/testutils/mock")
+
+	lis := bufconn.Listen(1 << 20)
+	s := grpc.NewServer()
+	Register(s, services.NewComponentService(models.NewModels(db)))
+	go func() { _ = s.Serve(lis) }()
+	t.Cleanup(s.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+	return modelsv1.NewComponentServiceClient(conn)
+}
+
+func TestGetComponent(t *testing.T) {
+	err := zlog.NewSugaredDevLogger()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a sugared logger", err)
+	}
+	defer zlog.SyncZap()
+	client := setupClient(t)
+	ctx := context.Background()
+
+	res, err := client.GetComponent(ctx, &modelsv1.ComponentRequest{Purl: "pkg:npm/react", Requirement: "^15.0.0"})
+	if err != nil {
+		t.Fatalf("an error '%v' was not expected", err)
+	}
+	if res.GetVersion() != "15.7.0" {
+		t.Errorf("GetComponent() version = %v, want %v", res.GetVersion(), "15.7.0")
+	}
+	fmt.Printf("Component: %v\n", res)
+
+	_, err = client.GetComponent(ctx, &modelsv1.ComponentRequest{Purl: "invalid-purl"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("GetComponent() code = %v, want %v", status.Code(err), codes.InvalidArgument)
+	}
+	_, err = client.GetComponent(ctx, &modelsv1.ComponentRequest{})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("GetComponent() code = %v, want %v", status.Code(err), codes.InvalidArgument)
+	}
+	_, err = client.GetComponent(ctx, &modelsv1.ComponentRequest{Purl: "pkg:npm/no-such-component"})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("GetComponent() code = %v, want %v", status.Code(err), codes.NotFound)
+	}
+	_, err = client.GetComponent(ctx, &modelsv1.ComponentRequest{Purl: "pkg:npm/react", Requirement: "^99.0.0"})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("GetComponent() code = %v, want %v", status.Code(err), codes.NotFound)
+	}
+}
+
+func TestGetComponents(t *testing.T) {
+	err := zlog.NewSugaredDevLogger()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a sugared logger", err)
+	}
+	defer zlog.SyncZap()
+	client := setupClient(t)
+	ctx := context.Background()
+
+	res, err := client.GetComponents(ctx, &modelsv1.ComponentsRequest{Components: []*modelsv1.ComponentRequest{
+		{Purl: "pkg:npm/electron-updater@4.0.8"},
+		{Purl: "invalid-purl"},
+	}})
+	if err != nil {
+		t.Fatalf("an error '%v' was not expected", err)
+	}
+	if len(res.GetResults()) != 2 {
+		t.Fatalf("GetComponents() results = %v, want 2", len(res.GetResults()))
+	}
+	if got := res.GetResults()[0].GetResponse().GetVersion(); got != "4.0.8" {
+		t.Errorf("GetComponents() version = %v, want %v", got, "4.0.8")
+	}
+	if got := res.GetResults()[0].GetResponse().GetPackageHash(); got != "c0763f9bee80979157d3af7ccd0a4b95" {
+		t.Errorf("GetComponents() package hash = %v, want %v", got, "c0763f9bee80979157d3af7ccd0a4b95")
+	}
+	if got := res.GetResults()[1].GetError().GetCode(); codes.Code(got) != codes.InvalidArgument { //nolint:gosec // test codes are small
+		t.Errorf("GetComponents() error code = %v, want %v", got, codes.InvalidArgument)
+	}
+	if res.GetResults()[1].GetRequest().GetPurl() != "invalid-purl" {
+		t.Errorf("GetComponents() request not echoed in result: %v", res.GetResults()[1])
+	}
+
+	_, err = client.GetComponents(ctx, &modelsv1.ComponentsRequest{})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("GetComponents() code = %v, want %v", status.Code(err), codes.InvalidArgument)
+	}
+	batch := make([]*modelsv1.ComponentRequest, MaxBatchSize+1)
+	for i := range batch {
+		batch[i] = &modelsv1.ComponentRequest{Purl: "pkg:npm/react"}
+	}
+	_, err = client.GetComponents(ctx, &modelsv1.ComponentsRequest{Components: batch})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("GetComponents() code = %v, want %v", status.Code(err), codes.InvalidArgument)
+	}
+}
+
+func TestStreamComponents(t *testing.T) {
+	err := zlog.NewSugaredDevLogger()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a sugared logger", err)
+	}
+	defer zlog.SyncZap()
+	client := setupClient(t)
+
+	stream, err := client.StreamComponents(context.Background())
+	if err != nil {
+		t.Fatalf("an error '%v' was not expected", err)
+	}
+	requests := []*modelsv1.ComponentRequest{
+		{Purl: "pkg:npm/react", Requirement: "^15.0.0"},
+		{Purl: ""},
+		{Purl: "pkg:npm/electron-updater@4.0.8"},
+	}
+	for _, req := range requests {
+		if err = stream.Send(req); err != nil {
+			t.Fatalf("failed to send request: %v", err)
+		}
+	}
+	if err = stream.CloseSend(); err != nil {
+		t.Fatalf("failed to close stream: %v", err)
+	}
+	var results []*modelsv1.ComponentResult
+	for {
+		res, recvErr := stream.Recv()
+		if errors.Is(recvErr, io.EOF) {
+			break
+		}
+		if recvErr != nil {
+			t.Fatalf("failed to receive result: %v", recvErr)
+		}
+		results = append(results, res)
+	}
+	if len(results) != len(requests) {
+		t.Fatalf("StreamComponents() results = %v, want %v", len(results), len(requests))
+	}
+	if results[0].GetResponse().GetVersion() != "15.7.0" || results[2].GetResponse().GetVersion() != "4.0.8" {
+		t.Errorf("StreamComponents() unexpected results: %v", results)
+	}
+	if results[1].GetError() == nil {
+		t.Errorf("StreamComponents() expected an error result for an empty purl: %v", results[1])
+	}
+}
+
+func TestCheckPurl(t *testing.T) {
+	err := zlog.NewSugaredDevLogger()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a sugared logger", err)
+	}
+	defer zlog.SyncZap()
+	client := setupClient(t)
+	ctx := context.Background()
+
+	res, err := client.CheckPurl(ctx, &modelsv1.CheckPurlRequest{Purl: "pkg:gem/tablestyle"})
+	if err != nil {
+		t.Fatalf("an error '%v' was not expected", err)
+	}
+	if res.GetProjects() != 1 {
+		t.Errorf("CheckPurl() projects = %v, want %v", res.GetProjects(), 1)
+	}
+	_, err = client.CheckPurl(ctx, &modelsv1.CheckPurlRequest{})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("CheckPurl() code = %v, want %v", status.Code(err), codes.InvalidArgument)
+	}
+}
@@ -0,0 +1,31 @@
+// SPDX-License-Identifier: GPL-2.0-or-later
+/*
+ * Copyright (C) 2026 SCANOSS.COM
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 2 of the License, or
+ * (at your option) any later version.
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package helpers
+
+import "strings"
+
+// PurlString builds a Package URL string from a Purl Type, Purl Name and (optional) version.
+func PurlString(purlType, purlName, version string) string {
+	// An npm scope starts with '@', which must be percent-encoded to keep it apart from the version separator
+	if strings.HasPrefix(purlName, "@") {
+		purlName = "%40" + purlName[1:]
+	}
+	if len(version) > 0 {
+		return "pkg:" + purlType + "/" + purlName + "@" + version
+	}
+	return "pkg:" + purlType + "/" + purlName
+}
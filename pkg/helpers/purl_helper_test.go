@@ -0,0 +1,37 @@
+// SPDX-License-Identifier: GPL-2.0-or-later
+/*
+ * Copyright (C) 2026 SCANOSS.COM
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 2 of the License, or
+ * (at your option) any later version.
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package helpers
+
+import "testing"
+
+func TestPurlString(t *testing.T) {
+	tests := []struct {
+		purlType string
+		purlName string
+		version  string
+		expected string
+	}{
+		{purlType: "npm", purlName: "react", version: "17.0.2", expected: "pkg:npm/react@17.0.2"},
+		{purlType: "npm", purlName: "@babel/core", version: "7.0.0", expected: "pkg:npm/%40babel/core@7.0.0"},
+		{purlType: "github", purlName: "scanoss/dependencies", expected: "pkg:github/scanoss/dependencies"},
+	}
+	for _, tt := range tests {
+		if got := PurlString(tt.purlType, tt.purlName, tt.version); got != tt.expected {
+			t.Errorf("PurlString(%v, %v, %v) = %v, want %v", tt.purlType, tt.purlName, tt.version, got, tt.expected)
+		}
+	}
+}
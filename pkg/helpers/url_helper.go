@@ -0,0 +1,94 @@
+// SPDX-License-Identifier: GPL-2.0-or-later
+/*
+ * Copyright (C) 2026 SCANOSS.COM
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 2 of the License, or
+ * (at your option) any later version.
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package helpers
+
+import (
+	"errors"
+	"net"
+	"net/url"
+	"strings"
+)
+
+// downloadHostAliases groups hosts serving the same download URLs (keyed by canonical host).
+// Only hosts using the same paths for an artifact are aliased.
+var downloadHostAliases = map[string][]string{
+	"registry.npmjs.org": {"registry.npmjs.org", "registry.npmjs.com", "registry.yarnpkg.com"},
+	"rubygems.org":       {"rubygems.org", "api.rubygems.org", "index.rubygems.org"},
+	"repo1.maven.org":    {"repo1.maven.org", "repo.maven.apache.org", "central.maven.org"},
+}
+
+// downloadHostCanonical maps each aliased host to its canonical host.
+var downloadHostCanonical = func() map[string]string {
+	canonical := make(map[string]string)
+	for host, aliases := range downloadHostAliases {
+		for _, alias := range aliases {
+			canonical[alias] = host
+		}
+	}
+	return canonical
+}()
+
+// DownloadURLVariants returns the download URL forms that refer to the same artifact as the given URL.
+// The URL itself (trimmed of surrounding whitespace) is returned first, followed by its variants across http/https, host aliases (including a
+// "www." prefix) and with/without a trailing slash. Fragments and default ports are dropped.
+func DownloadURLVariants(rawURL string) ([]string, error) {
+	u, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil {
+		return nil, err
+	}
+	scheme := strings.ToLower(u.Scheme)
+	if scheme != "http" && scheme != "https" {
+		return nil, errors.New("download URL must use http or https")
+	}
+	host := strings.ToLower(u.Hostname())
+	if len(host) == 0 {
+		return nil, errors.New("download URL has no host")
+	}
+	if port := u.Port(); len(port) > 0 && port != "80" && port != "443" {
+		host = net.JoinHostPort(host, port)
+	}
+	host = strings.TrimPrefix(host, "www.")
+	if canonical, ok := downloadHostCanonical[host]; ok {
+		host = canonical
+	}
+	hosts := downloadHostAliases[host]
+	if len(hosts) == 0 {
+		hosts = []string{host}
+	}
+	path := strings.TrimSuffix(u.EscapedPath(), "/")
+	query := ""
+	if len(u.RawQuery) > 0 {
+		query = "?" + u.RawQuery
+	}
+
+	variants := []string{strings.TrimSpace(rawURL)}
+	seen := map[string]bool{variants[0]: true}
+	for _, h := range hosts {
+		for _, hostVariant := range []string{h, "www." + h} {
+			for _, s := range []string{"https", "http"} {
+				for _, p := range []string{path, path + "/"} {
+					v := s + "://" + hostVariant + p + query
+					if !seen[v] {
+						seen[v] = true
+						variants = append(variants, v)
+					}
+				}
+			}
+		}
+	}
+	return variants, nil
+}
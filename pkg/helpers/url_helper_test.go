@@ -0,0 +1,84 @@
+// SPDX-License-Identifier: GPL-2.0-or-later
+/*
+ * Copyright (C) 2026 SCANOSS.COM
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 2 of the License, or
+ * (at your option) any later version.
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package helpers
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestDownloadURLVariants(t *testing.T) {
+	tests := []struct {
+		input    string
+		contains []string
+		excludes []string
+		wantErr  bool
+	}{
+		{
+			input:    "https://rubygems.org/downloads/tablestyle-0.0.10.gem",
+			contains: []string{"http://rubygems.org/downloads/tablestyle-0.0.10.gem", "https://www.rubygems.org/downloads/tablestyle-0.0.10.gem", "https://api.rubygems.org/downloads/tablestyle-0.0.10.gem"},
+		},
+		{
+			input:    "HTTP://Registry.Yarnpkg.com:80/react/-/react-17.0.2.tgz/#frag",
+			contains: []string{"https://registry.npmjs.org/react/-/react-17.0.2.tgz"},
+		},
+		{
+			input:    "https://example.com:8443/pkg.tar.gz?raw=1",
+			contains: []string{"http://example.com:8443/pkg.tar.gz?raw=1", "https://www.example.com:8443/pkg.tar.gz/?raw=1"},
+		},
+		{
+			input:    "https://github.com/scanoss/go-models/archive/refs/tags/v0.6.0.tar.gz",
+			contains: []string{"http://www.github.com/scanoss/go-models/archive/refs/tags/v0.6.0.tar.gz"},
+			excludes: []string{"https://codeload.github.com/scanoss/go-models/archive/refs/tags/v0.6.0.tar.gz"},
+		},
+		{
+			input:    " https://files.pythonhosted.org/packages/binaryornot-0.4.4.tar.gz ",
+			contains: []string{"https://files.pythonhosted.org/packages/binaryornot-0.4.4.tar.gz"},
+			excludes: []string{"https://pypi.org/packages/binaryornot-0.4.4.tar.gz"},
+		},
+		{input: "ftp://example.com/pkg.tar.gz", wantErr: true},
+		{input: "not a url", wantErr: true},
+		{input: "https:///pkg.tar.gz", wantErr: true},
+	}
+	for _, tt := range tests {
+		variants, err := DownloadURLVariants(tt.input)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("DownloadURLVariants(%v) expected an error, got %v", tt.input, variants)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("DownloadURLVariants(%v) unexpected error: %v", tt.input, err)
+			continue
+		}
+		if variants[0] != strings.TrimSpace(tt.input) {
+			t.Errorf("DownloadURLVariants(%v) first variant = %v, want the trimmed input", tt.input, variants[0])
+		}
+		for _, want := range tt.contains {
+			if !slices.Contains(variants, want) {
+				t.Errorf("DownloadURLVariants(%v) = %v, missing %v", tt.input, variants, want)
+			}
+		}
+		for _, unwanted := range tt.excludes {
+			if slices.Contains(variants, unwanted) {
+				t.Errorf("DownloadURLVariants(%v) = %v, unexpected %v", tt.input, variants, unwanted)
+			}
+		}
+	}
+}
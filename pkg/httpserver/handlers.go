@@ -0,0 +1,188 @@
+// SPDX-License-Identifier: GPL-2.0-or-later
+/*
+ * Copyright (C) 2026 SCANOSS.COM
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 2 of the License, or
+ * (at your option) any later version.
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package httpserver
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/scanoss/go-models/pkg/models"
+	"github.com/scanoss/go-models/pkg/types"
+	purlutils "github.com/scanoss/go-purl-helper/pkg"
+)
+
+// handleGetComponent resolves a component from the purl, requirement and as_of query parameters.
+func (s *Server) handleGetComponent(w http.ResponseWriter, r *http.Request) {
+	req := types.ComponentRequest{
+		Purl:        r.URL.Query().Get("purl"),
+		Requirement: r.URL.Query().Get("requirement"),
+		AsOf:        r.URL.Query().Get("as_of"),
+	}
+	s.getComponent(w, r, req)
+}
+
+// handlePostComponent resolves a component from a JSON types.ComponentRequest body.
+func (s *Server) handlePostComponent(w http.ResponseWriter, r *http.Request) {
+	var req types.ComponentRequest
+	if err := decodeJSON(w, r, &req); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	s.getComponent(w, r, req)
+}
+
+// getComponent validates the component request and resolves it using the component service.
+func (s *Server) getComponent(w http.ResponseWriter, r *http.Request, req types.ComponentRequest) {
+	if err := validateComponentRequest(req); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	res, err := s.client.Component.GetComponent(r.Context(), req)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, r, http.StatusOK, res)
+}
+
+// handleCheckPurl returns the number of projects matching the purl query parameter.
+func (s *Server) handleCheckPurl(w http.ResponseWriter, r *http.Request) {
+	purl := r.URL.Query().Get("purl")
+	if err := validatePurl(purl); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	count, err := s.client.Component.CheckPurl(r.Context(), purl)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, r, http.StatusOK, types.CheckPurlResponse{Purl: purl, Projects: count})
+}
+
+// handleLicenseByID returns the license with the given ID path parameter.
+func (s *Server) handleLicenseByID(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(r.PathValue("id"), 10, 32)
+	if err != nil || id < 0 {
+		writeError(w, r, invalidRequest(fmt.Errorf("invalid license id: %q", r.PathValue("id"))))
+		return
+	}
+	license, err := s.client.Models.Licenses.GetLicenseByID(r.Context(), int32(id))
+	s.writeLicense(w, r, license, err)
+}
+
+// handleLicenseByName returns the license with the given name query parameter.
+func (s *Server) handleLicenseByName(w http.ResponseWriter, r *http.Request) {
+	name := r.URL.Query().Get("name")
+	if len(name) == 0 {
+		writeError(w, r, invalidRequest(errors.New("please specify a license name")))
+		return
+	}
+	license, err := s.client.Models.Licenses.GetLicenseByName(r.Context(), name)
+	s.writeLicense(w, r, license, err)
+}
+
+// writeLicense writes the result of a license lookup.
+func (s *Server) writeLicense(w http.ResponseWriter, r *http.Request, license models.License, err error) {
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, r, http.StatusOK, types.License{ID: license.ID, Name: license.LicenseName, SPDXID: license.SPDX, IsSpdx: license.IsSpdx})
+}
+
+// handleVersion returns the version with the given name query parameter.
+func (s *Server) handleVersion(w http.ResponseWriter, r *http.Request) {
+	name := r.URL.Query().Get("name")
+	if len(name) == 0 {
+		writeError(w, r, invalidRequest(errors.New("please specify a version name")))
+		return
+	}
+	version, err := s.client.Models.Versions.GetVersionByName(r.Context(), name)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, r, http.StatusOK, types.Version{ID: version.ID, Name: version.VersionName, SemVer: version.SemVer})
+}
+
+// handleMines returns the mine IDs for the purl_type query parameter.
+func (s *Server) handleMines(w http.ResponseWriter, r *http.Request) {
+	purlType := r.URL.Query().Get("purl_type")
+	if len(purlType) == 0 {
+		writeError(w, r, invalidRequest(errors.New("please specify a purl type")))
+		return
+	}
+	mineIDs, err := s.client.Models.Mines.GetMineIdsByPurlType(r.Context(), purlType)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, r, http.StatusOK, types.MinesResponse{PurlType: purlType, MineIDs: mineIDs})
+}
+
+// handleDBVersion returns the version information of every knowledge base package.
+func (s *Server) handleDBVersion(w http.ResponseWriter, r *http.Request) {
+	versions, err := s.client.Models.DBVersion.GetVersions(r.Context())
+	if err != nil {
+		if errors.Is(err, models.ErrTableNotFound) {
+			err = notFound(errors.New("no version information available"))
+		}
+		writeError(w, r, err)
+		return
+	}
+	res := make([]types.DBVersion, 0, len(versions))
+	for _, v := range versions {
+		res = append(res, types.DBVersion{
+			PackageName:   v.PackageName,
+			SchemaVersion: v.SchemaVersion.String(),
+			Release:       v.Release.String(),
+			CreatedAt:     v.CreatedAt.Format(time.RFC3339),
+		})
+	}
+	writeJSON(w, r, http.StatusOK, res)
+}
+
+// validateComponentRequest checks that a component request is well-formed before it is resolved.
+func validateComponentRequest(req types.ComponentRequest) error {
+	if err := validatePurl(req.Purl); err != nil {
+		return err
+	}
+	if len(req.Requirement) > 0 {
+		if purl, _ := purlutils.PurlFromString(req.Purl); len(purl.Version) > 0 {
+			return invalidRequest(errors.New("cannot specify both a version and a requirement"))
+		}
+	}
+	return nil
+}
+
+// validatePurl checks that the given string is a valid purl with a name.
+func validatePurl(purl string) error {
+	if len(purl) == 0 {
+		return invalidRequest(errors.New("please specify a valid purl"))
+	}
+	if _, err := purlutils.PurlFromString(purl); err != nil {
+		return invalidRequest(fmt.Errorf("failed to parse purl: %w", err))
+	}
+	if _, err := purlutils.PurlNameFromString(purl); err != nil {
+		return invalidRequest(fmt.Errorf("failed to extract purl name: %w", err))
+	}
+	return nil
+}
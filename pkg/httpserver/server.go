@@ -0,0 +1,147 @@
+// SPDX-License-Identifier: GPL-2.0-or-later
+/*
+ * Copyright (C) 2026 SCANOSS.COM
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 2 of the License, or
+ * (at your option) any later version.
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+// Package httpserver exposes the SCANOSS component service and model lookups as HTTP/JSON endpoints.
+//
+// The request and response bodies use the types package as the wire contract.
+// Errors are returned as a types.ErrorResponse with a matching HTTP status code.
+// Request logging can be enabled by wrapping the handler with middleware that adds a ctxzap logger to the request context.
+package httpserver
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
+	"github.com/scanoss/go-models/pkg/models"
+	"github.com/scanoss/go-models/pkg/scanoss"
+	"github.com/scanoss/go-models/pkg/types"
+)
+
+// maxRequestBytes is the maximum size of a request body.
+const maxRequestBytes = 1 << 20
+
+// Error codes returned in types.ErrorResponse.
+const (
+	CodeInvalidRequest = "invalid_request"
+	CodeNotFound       = "not_found"
+	CodeNoVersionMatch = "no_version_match"
+	CodeUnavailable    = "unavailable"
+	CodeInternal       = "internal"
+)
+
+// Server serves the SCANOSS HTTP/JSON API.
+type Server struct {
+	client *scanoss.Client
+	mux    *http.ServeMux
+}
+
+// New creates a new HTTP server wrapping the given SCANOSS client.
+func New(client *scanoss.Client) *Server {
+	s := &Server{
+		client: client,
+		mux:    http.NewServeMux(),
+	}
+	s.mux.HandleFunc("GET /v1/component", s.handleGetComponent)
+	s.mux.HandleFunc("POST /v1/component", s.handlePostComponent)
+	s.mux.HandleFunc("GET /v1/purl/check", s.handleCheckPurl)
+	s.mux.HandleFunc("GET /v1/licenses", s.handleLicenseByName)
+	s.mux.HandleFunc("GET /v1/licenses/{id}", s.handleLicenseByID)
+	s.mux.HandleFunc("GET /v1/versions", s.handleVersion)
+	s.mux.HandleFunc("GET /v1/mines", s.handleMines)
+	s.mux.HandleFunc("GET /v1/db-version", s.handleDBVersion)
+	return s
+}
+
+// ServeHTTP dispatches the request to the matching endpoint.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.ServeHTTP(w, r)
+}
+
+// apiError is an error with an associated HTTP status and error code.
+type apiError struct {
+	status int
+	code   string
+	err    error
+}
+
+func (e *apiError) Error() string {
+	return e.err.Error()
+}
+
+func (e *apiError) Unwrap() error {
+	return e.err
+}
+
+// invalidRequest creates an API error for a malformed or invalid request.
+func invalidRequest(err error) error {
+	return &apiError{status: http.StatusBadRequest, code: CodeInvalidRequest, err: err}
+}
+
+// notFound creates an API error for a resource that does not exist.
+func notFound(err error) error {
+	return &apiError{status: http.StatusNotFound, code: CodeNotFound, err: err}
+}
+
+// writeJSON writes the given value as a JSON response with the given status.
+func writeJSON(w http.ResponseWriter, r *http.Request, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		ctxzap.Extract(r.Context()).Sugar().Warnf("Problem writing response: %v", err)
+	}
+}
+
+// writeError writes the given error as a structured JSON error response.
+func writeError(w http.ResponseWriter, r *http.Request, err error) {
+	var apiErr *apiError
+	if !errors.As(err, &apiErr) {
+		apiErr = &apiError{status: http.StatusInternalServerError, code: CodeInternal, err: err}
+		switch {
+		case errors.Is(err, models.ErrInvalidInput):
+			apiErr.status, apiErr.code = http.StatusBadRequest, CodeInvalidRequest
+		case errors.Is(err, models.ErrNoVersionMatch):
+			apiErr.status, apiErr.code = http.StatusNotFound, CodeNoVersionMatch
+		case errors.Is(err, models.ErrNotFound):
+			apiErr.status, apiErr.code = http.StatusNotFound, CodeNotFound
+		case errors.Is(err, models.ErrSchemaIncompatible), errors.Is(err, models.ErrTableNotFound):
+			apiErr.status, apiErr.code = http.StatusServiceUnavailable, CodeUnavailable
+		}
+	}
+	if apiErr.status >= http.StatusInternalServerError {
+		ctxzap.Extract(r.Context()).Sugar().Errorf("Request %v %v failed: %v", r.Method, r.URL.Path, err)
+	}
+	message := apiErr.Error()
+	if apiErr.code == CodeInternal {
+		// Internal errors may carry SQL or other implementation details, which are only logged
+		message = "internal server error"
+	}
+	writeJSON(w, r, apiErr.status, types.ErrorResponse{Code: apiErr.code, Message: message})
+}
+
+// decodeJSON decodes a JSON request body into the given value, rejecting unknown fields.
+func decodeJSON(w http.ResponseWriter, r *http.Request, v any) error {
+	dec := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRequestBytes))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(v); err != nil {
+		return invalidRequest(errors.New("invalid JSON request body: " + err.Error()))
+	}
+	if dec.More() {
+		return invalidRequest(errors.New("invalid JSON request body: unexpected trailing data"))
+	}
+	return nil
+}
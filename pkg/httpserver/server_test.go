@@ -0,0 +1,165 @@
+// SPDX-License-Identifier: GPL-2.0-or-later
+/*
+ * Copyright (C) 2026 SCANOSS.COM
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 2 of the License, or
+ * (at your option) any later version.
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package httpserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/scanoss/go-models/internal/testutils"
+	"github.com/scanoss/go-models/pkg/models"
+	"github.com/scanoss/go-models/pkg/scanoss"
+	"github.com/scanoss/go-models/pkg/types"
+	zlog "github.com/scanoss/zap-logging-helper/pkg/logger"
+)
+
+func TestServer(t *testing.T) {
+	err := zlog.NewSugaredDevLogger()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a sugared logger", err)
+	}
+	defer zlog.SyncZap()
+	db := testutils.SqliteSetup(t) // Setup SQL Lite DB
+	defer testutils.CloseDB(t, db)
+	testutils.LoadMockSQLData(t, db, "../../internal/testutils/mock")
+	testutils.LoadSQLDataFile(t, db, "../../internal/testutils/mock/db_version.sql")
+
+	ts := httptest.NewServer(New(scanoss.New(db)))
+	defer ts.Close()
+
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		body       string
+		wantStatus int
+		wantBody   string
+	}{
+		{name: "get component", method: http.MethodGet, path: "/v1/component?purl=pkg:npm/react&requirement=%5E15.0.0", wantStatus: http.StatusOK, wantBody: `"version":"15.7.0"`},
+		{name: "post component", method: http.MethodPost, path: "/v1/component", body: `{"purl":"pkg:npm/electron-updater@4.0.8"}`, wantStatus: http.StatusOK, wantBody: `"version":"4.0.8"`},
+		{
+			name: "get component as of", method: http.MethodGet, path: "/v1/component?purl=pkg:npm/electron-updater&requirement=%5E4.0.0&as_of=2021-06-01",
+			wantStatus: http.StatusOK, wantBody: `"version":"4.3.9"`,
+		},
+		{name: "post component as of", method: http.MethodPost, path: "/v1/component", body: `{"purl":"pkg:npm/electron-updater","as_of":"2021-05-11"}`, wantStatus: http.StatusOK, wantBody: `"version":"4.3.9"`},
+		{name: "component invalid as of", method: http.MethodGet, path: "/v1/component?purl=pkg:npm/react&as_of=yesterday", wantStatus: http.StatusBadRequest, wantBody: CodeInvalidRequest},
+		{name: "post component bad json", method: http.MethodPost, path: "/v1/component", body: `{"purl":`, wantStatus: http.StatusBadRequest, wantBody: CodeInvalidRequest},
+		{name: "post component unknown field", method: http.MethodPost, path: "/v1/component", body: `{"purl":"pkg:npm/react","rubbish":1}`, wantStatus: http.StatusBadRequest, wantBody: CodeInvalidRequest},
+		{name: "component missing purl", method: http.MethodGet, path: "/v1/component", wantStatus: http.StatusBadRequest, wantBody: CodeInvalidRequest},
+		{name: "component invalid purl", method: http.MethodGet, path: "/v1/component?purl=invalid-purl", wantStatus: http.StatusBadRequest, wantBody: CodeInvalidRequest},
+		{
+			name: "component version and requirement", method: http.MethodPost, path: "/v1/component",
+			body: `{"purl":"pkg:npm/react@17.0.2","requirement":"^17.0.0"}`, wantStatus: http.StatusBadRequest, wantBody: CodeInvalidRequest,
+		},
+		{name: "component not found", method: http.MethodGet, path: "/v1/component?purl=pkg:npm/no-such-component", wantStatus: http.StatusNotFound, wantBody: CodeNotFound},
+		{
+			name: "component no version match", method: http.MethodGet, path: "/v1/component?purl=pkg:npm/react&requirement=%5E99.0.0",
+			wantStatus: http.StatusNotFound, wantBody: CodeNoVersionMatch,
+		},
+		{name: "check purl", method: http.MethodGet, path: "/v1/purl/check?purl=pkg:gem/tablestyle", wantStatus: http.StatusOK, wantBody: `"projects":1`},
+		{name: "check purl invalid", method: http.MethodGet, path: "/v1/purl/check", wantStatus: http.StatusBadRequest, wantBody: CodeInvalidRequest},
+		{name: "license by id", method: http.MethodGet, path: "/v1/licenses/5614", wantStatus: http.StatusOK, wantBody: `"spdx_id":"MIT"`},
+		{name: "license by id invalid", method: http.MethodGet, path: "/v1/licenses/abc", wantStatus: http.StatusBadRequest, wantBody: CodeInvalidRequest},
+		{name: "license by id with empty name", method: http.MethodGet, path: "/v1/licenses/9999", wantStatus: http.StatusOK, wantBody: `"id":9999`},
+		{name: "license by id not found", method: http.MethodGet, path: "/v1/licenses/1", wantStatus: http.StatusNotFound, wantBody: CodeNotFound},
+		{name: "license by name", method: http.MethodGet, path: "/v1/licenses?name=Apache+2.0", wantStatus: http.StatusOK, wantBody: `"id":552`},
+		{name: "license by name not found", method: http.MethodGet, path: "/v1/licenses?name=No+Such+License", wantStatus: http.StatusNotFound, wantBody: CodeNotFound},
+		{name: "license by name missing", method: http.MethodGet, path: "/v1/licenses", wantStatus: http.StatusBadRequest, wantBody: CodeInvalidRequest},
+		{name: "version", method: http.MethodGet, path: "/v1/versions?name=0.14.6", wantStatus: http.StatusOK, wantBody: `"semver":"v0.14.6"`},
+		{name: "version not found", method: http.MethodGet, path: "/v1/versions?name=99.99.99-none", wantStatus: http.StatusNotFound, wantBody: CodeNotFound},
+		{name: "mines", method: http.MethodGet, path: "/v1/mines?purl_type=maven", wantStatus: http.StatusOK, wantBody: `"mine_ids":[0,15]`},
+		{name: "mines missing type", method: http.MethodGet, path: "/v1/mines", wantStatus: http.StatusBadRequest, wantBody: CodeInvalidRequest},
+		{name: "db version", method: http.MethodGet, path: "/v1/db-version", wantStatus: http.StatusOK, wantBody: `"db_release":"2026.01"`},
+		{name: "method not allowed", method: http.MethodDelete, path: "/v1/component", wantStatus: http.StatusMethodNotAllowed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, reqErr := http.NewRequest(tt.method, ts.URL+tt.path, strings.NewReader(tt.body))
+			if reqErr != nil {
+				t.Fatalf("failed to create request: %v", reqErr)
+			}
+			resp, respErr := http.DefaultClient.Do(req)
+			if respErr != nil {
+				t.Fatalf("request failed: %v", respErr)
+			}
+			defer func() { _ = resp.Body.Close() }()
+			body, readErr := io.ReadAll(resp.Body)
+			if readErr != nil {
+				t.Fatalf("failed to read response: %v", readErr)
+			}
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("%v %v status = %v, want %v (%v)", tt.method, tt.path, resp.StatusCode, tt.wantStatus, string(body))
+			}
+			if !strings.Contains(string(body), tt.wantBody) {
+				t.Errorf("%v %v body = %v, want %v", tt.method, tt.path, string(body), tt.wantBody)
+			}
+			if resp.StatusCode >= http.StatusBadRequest && resp.StatusCode != http.StatusMethodNotAllowed {
+				var errResp types.ErrorResponse
+				if jsonErr := json.Unmarshal(body, &errResp); jsonErr != nil || len(errResp.Message) == 0 {
+					t.Errorf("%v %v expected structured error, got %v", tt.method, tt.path, string(body))
+				}
+			}
+		})
+	}
+}
+
+func TestServerIncompatibleSchema(t *testing.T) {
+	db := testutils.SqliteSetup(t)
+	defer testutils.CloseDB(t, db)
+	testutils.LoadMockSQLData(t, db, "../../internal/testutils/mock")
+	testutils.LoadSQLDataFile(t, db, "../../internal/testutils/mock/db_version.sql")
+	if _, err := db.Exec("UPDATE db_version SET schema_version = '2.0.0'"); err != nil {
+		t.Fatalf("failed to update db_version table: %v", err)
+	}
+
+	ts := httptest.NewServer(New(scanoss.New(db)))
+	defer ts.Close()
+
+	// The model lookups are disabled by the models themselves, not only by the component service
+	for _, path := range []string{"/v1/licenses/5614", "/v1/licenses?name=MIT", "/v1/versions?name=0.14.6", "/v1/mines?purl_type=maven"} {
+		resp, err := http.Get(ts.URL + path)
+		if err != nil {
+			t.Fatalf("request failed: %v", err)
+		}
+		body, _ := io.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+		if resp.StatusCode != http.StatusServiceUnavailable || !strings.Contains(string(body), CodeUnavailable) {
+			t.Errorf("GET %v status = %v, want %v (%v)", path, resp.StatusCode, http.StatusServiceUnavailable, string(body))
+		}
+	}
+}
+
+func TestWriteErrorInternal(t *testing.T) {
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/v1/versions?name=1.0.0", nil)
+	writeError(rec, req, fmt.Errorf("%w: failed to query the versions table: no such column: v.secret", models.ErrDatabase))
+	if rec.Code != http.StatusInternalServerError {
+		t.Errorf("writeError() status = %v, want %v", rec.Code, http.StatusInternalServerError)
+	}
+	var errResp types.ErrorResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &errResp); err != nil {
+		t.Fatalf("writeError() returned invalid JSON: %v", err)
+	}
+	if errResp.Code != CodeInternal || strings.Contains(errResp.Message, "secret") || len(errResp.Message) == 0 {
+		t.Errorf("writeError() expected a generic internal error, got %#v", errResp)
+	}
+}
@@ -0,0 +1,159 @@
+// SPDX-License-Identifier: GPL-2.0-or-later
+/*
+ * Copyright (C) 2026 SCANOSS.COM
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 2 of the License, or
+ * (at your option) any later version.
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package manifest
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/scanoss/go-models/pkg/helpers"
+	"github.com/scanoss/go-models/pkg/types"
+)
+
+var tomlStringRegex = regexp.MustCompile(`([A-Za-z0-9_-]+)\s*=\s*"([^"]*)"`) // regex to match key = "value" pairs
+
+// cargoDependency holds the details of a Cargo dependency.
+type cargoDependency struct {
+	name    string
+	version string
+	source  bool // sourced from git or a local path
+}
+
+// ParseCargoToml parses the dependencies of a Rust Cargo.toml file, including dev, build and target dependencies.
+// Only the subset of TOML used to declare dependencies is supported. Git and path dependencies
+// without a version are skipped.
+func ParseCargoToml(r io.Reader) ([]types.ComponentRequest, error) {
+	var c collector
+	var section string         // the current table, e.g. dependencies or target.'cfg(unix)'.dependencies
+	var table *cargoDependency // the current dependency table, e.g. [dependencies.serde]
+	flush := func() {
+		if table != nil {
+			addCargoDependency(&c, table)
+			table = nil
+		}
+	}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(stripTomlComment(scanner.Text()))
+		if strings.HasPrefix(line, "[") {
+			flush()
+			section = strings.Trim(line, "[] ")
+			if name, ok := cargoDependencyTable(section); ok {
+				table = &cargoDependency{name: name}
+			}
+			continue
+		}
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			continue
+		}
+		key, value = strings.Trim(strings.TrimSpace(key), `"`), strings.TrimSpace(value)
+		if table != nil {
+			setCargoField(table, key+" = "+value)
+			continue
+		}
+		if !isCargoDependencySection(section) || strings.Contains(key, ".") {
+			continue // not a dependency, or a dotted key such as serde.workspace = true
+		}
+		dep := &cargoDependency{name: key}
+		if strings.HasPrefix(value, "{") {
+			setCargoField(dep, value)
+		} else {
+			dep.version = strings.Trim(value, `"`)
+		}
+		addCargoDependency(&c, dep)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	flush()
+	return c.reqs, nil
+}
+
+// setCargoField sets the dependency fields found in the given key = "value" pairs.
+func setCargoField(dep *cargoDependency, pairs string) {
+	for _, m := range tomlStringRegex.FindAllStringSubmatch(pairs, -1) {
+		switch m[1] {
+		case "version":
+			dep.version = m[2]
+		case "package":
+			dep.name = m[2]
+		case "git", "path":
+			dep.source = true
+		}
+	}
+}
+
+// addCargoDependency adds a Cargo dependency, unless it is a git or path dependency without a version.
+func addCargoDependency(c *collector, dep *cargoDependency) {
+	if dep.source && len(dep.version) == 0 {
+		return
+	}
+	c.add(helpers.PurlString("cargo", dep.name, ""), cargoRequirement(dep.version))
+}
+
+// isCargoDependencySection reports whether the given table holds dependencies.
+func isCargoDependencySection(section string) bool {
+	for _, suffix := range []string{"dependencies", "dev-dependencies", "build-dependencies"} {
+		if section == suffix || strings.HasSuffix(section, "."+suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// cargoDependencyTable returns the dependency name of a table such as dependencies.serde.
+func cargoDependencyTable(section string) (string, bool) {
+	i := strings.LastIndex(section, ".")
+	if i < 0 || !isCargoDependencySection(section[:i]) {
+		return "", false
+	}
+	return strings.Trim(section[i+1:], `"`), true
+}
+
+// stripTomlComment removes a trailing comment, ignoring '#' characters inside strings.
+func stripTomlComment(line string) string {
+	inString := false
+	for i, ch := range line {
+		switch {
+		case ch == '"':
+			inString = !inString
+		case ch == '#' && !inString:
+			return line[:i]
+		}
+	}
+	return line
+}
+
+// cargoRequirement converts a Cargo version requirement to a version constraint.
+// A bare version (e.g. 1.2) is a caret requirement in Cargo.
+func cargoRequirement(spec string) string {
+	var clauses []string
+	for _, clause := range strings.Split(spec, ",") {
+		clause = strings.TrimSpace(clause)
+		if len(clause) == 0 {
+			continue
+		}
+		if clause[0] >= '0' && clause[0] <= '9' {
+			clause = "^" + clause
+		}
+		clauses = append(clauses, clause)
+	}
+	return strings.Join(clauses, ", ")
+}
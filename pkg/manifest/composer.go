@@ -0,0 +1,89 @@
+// SPDX-License-Identifier: GPL-2.0-or-later
+/*
+ * Copyright (C) 2026 SCANOSS.COM
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 2 of the License, or
+ * (at your option) any later version.
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package manifest
+
+import (
+	"encoding/json"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/scanoss/go-models/pkg/helpers"
+	"github.com/scanoss/go-models/pkg/types"
+)
+
+var composerOperatorRegex = regexp.MustCompile(`([<>=!~^]+)\s+`) // regex to match an operator followed by spaces
+var composerStabilityRegex = regexp.MustCompile(`@\w+$`)         // regex to match a stability flag, e.g. @dev
+
+// composerJSON represents the dependency sections of a PHP composer.json file.
+type composerJSON struct {
+	Require    map[string]string `json:"require"`
+	RequireDev map[string]string `json:"require-dev"`
+}
+
+// ParseComposerJSON parses the dependencies of a PHP composer.json file.
+// Platform requirements (e.g. php or ext-json) are skipped.
+func ParseComposerJSON(r io.Reader) ([]types.ComponentRequest, error) {
+	var composer composerJSON
+	if err := json.NewDecoder(r).Decode(&composer); err != nil {
+		return nil, err
+	}
+	var c collector
+	for _, deps := range []map[string]string{composer.Require, composer.RequireDev} {
+		for _, name := range sortedKeys(deps) {
+			if !strings.Contains(name, "/") {
+				continue // platform packages have no vendor
+			}
+			c.add(helpers.PurlString("composer", strings.ToLower(name), ""), composerRequirement(deps[name]))
+		}
+	}
+	return c.reqs, nil
+}
+
+// composerRequirement converts a Composer version constraint to a version constraint.
+// Branch constraints (e.g. dev-main) are dropped, as they do not refer to a release.
+func composerRequirement(spec string) string {
+	spec = composerOperatorRegex.ReplaceAllString(strings.TrimSpace(spec), "$1")
+	var groups []string
+	for _, group := range strings.Split(spec, "|") {
+		group = strings.TrimSpace(group)
+		if len(group) == 0 {
+			continue
+		}
+		if strings.Contains(group, " - ") { // hyphenated range
+			groups = append(groups, group)
+			continue
+		}
+		var clauses []string
+		for _, clause := range strings.FieldsFunc(group, func(r rune) bool { return r == ',' || r == ' ' }) {
+			clause = composerStabilityRegex.ReplaceAllString(clause, "")
+			switch {
+			case len(clause) == 0:
+				continue
+			case strings.HasPrefix(clause, "dev-") || strings.HasSuffix(clause, "-dev"):
+				return ""
+			case strings.HasPrefix(clause, "~"):
+				clause = compatibleRelease(strings.TrimPrefix(clause, "~"))
+			}
+			clauses = append(clauses, clause)
+		}
+		if len(clauses) > 0 {
+			groups = append(groups, strings.Join(clauses, ", "))
+		}
+	}
+	return strings.Join(groups, " || ")
+}
@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: GPL-2.0-or-later
+/*
+ * Copyright (C) 2026 SCANOSS.COM
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 2 of the License, or
+ * (at your option) any later version.
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package manifest
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/scanoss/go-models/pkg/helpers"
+	"github.com/scanoss/go-models/pkg/types"
+)
+
+var gemLineRegex = regexp.MustCompile(`^gem\s*\(?\s*['"]([^'"]+)['"](.*)$`) // regex to parse a Gemfile gem declaration
+var gemQuotedRegex = regexp.MustCompile(`^['"]([^'"]*)['"]$`)               // regex to match a quoted string argument
+var gemSourceRegex = regexp.MustCompile(`^:?(git|github|path|gist)\b`)      // regex to match the options of a non-rubygems gem
+
+// ParseGemfile parses the gems declared in a Ruby Gemfile.
+// Gems sourced from git or a local path are skipped.
+func ParseGemfile(r io.Reader) ([]types.ComponentRequest, error) {
+	var c collector
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line, _, _ := strings.Cut(strings.TrimSpace(scanner.Text()), "#")
+		m := gemLineRegex.FindStringSubmatch(strings.TrimSpace(line))
+		if m == nil {
+			continue
+		}
+		var clauses []string
+		registry := true
+		for _, arg := range strings.Split(m[2], ",") {
+			arg = strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(arg), ")"))
+			if q := gemQuotedRegex.FindStringSubmatch(arg); q != nil {
+				clauses = append(clauses, gemRequirement(q[1]))
+			} else if gemSourceRegex.MatchString(arg) {
+				registry = false
+			}
+		}
+		if registry {
+			c.add(helpers.PurlString("gem", m[1], ""), strings.Join(clauses, ", "))
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return c.reqs, nil
+}
+
+// gemRequirement converts a RubyGems version requirement to a version constraint.
+func gemRequirement(clause string) string {
+	clause = strings.TrimSpace(clause)
+	if strings.HasPrefix(clause, "~>") {
+		return compatibleRelease(strings.TrimPrefix(clause, "~>"))
+	}
+	return strings.ReplaceAll(clause, " ", "")
+}
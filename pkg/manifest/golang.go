@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: GPL-2.0-or-later
+/*
+ * Copyright (C) 2026 SCANOSS.COM
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 2 of the License, or
+ * (at your option) any later version.
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package manifest
+
+import (
+	"bufio"
+	"io"
+	"strings"
+
+	"github.com/scanoss/go-models/pkg/helpers"
+	"github.com/scanoss/go-models/pkg/types"
+)
+
+// ParseGoMod parses the required modules (direct and indirect) of a Go go.mod file.
+// Replace directives are not applied.
+func ParseGoMod(r io.Reader) ([]types.ComponentRequest, error) {
+	var c collector
+	var block string // the directive of the current block, e.g. require
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line, _, _ := strings.Cut(scanner.Text(), "//")
+		fields := strings.Fields(line)
+		switch {
+		case len(fields) == 0:
+			continue
+		case len(block) > 0 && fields[0] == ")":
+			block = ""
+			continue
+		case len(block) == 0 && len(fields) == 2 && fields[1] == "(":
+			block = fields[0]
+			continue
+		}
+		if len(block) == 0 && fields[0] == "require" {
+			fields = fields[1:]
+		} else if block != "require" {
+			continue
+		}
+		if len(fields) == 2 {
+			c.add(helpers.PurlString("golang", fields[0], ""), fields[1])
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return c.reqs, nil
+}
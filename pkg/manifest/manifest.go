@@ -0,0 +1,105 @@
+// SPDX-License-Identifier: GPL-2.0-or-later
+/*
+ * Copyright (C) 2026 SCANOSS.COM
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 2 of the License, or
+ * (at your option) any later version.
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+// Package manifest parses dependency manifest and lock files into component requests.
+//
+// Each parser emits a types.ComponentRequest per dependency, ready to be passed to
+// ComponentService.GetComponent. Declared version ranges are converted to the constraint syntax
+// understood by the component service and returned as the requirement, while lock files pin the
+// resolved version in the purl itself.
+package manifest
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/scanoss/go-models/pkg/types"
+)
+
+// ErrUnsupportedManifest is returned when there is no parser for a manifest file.
+var ErrUnsupportedManifest = errors.New("unsupported manifest file")
+
+// Parser extracts the component requests from a manifest file.
+type Parser func(r io.Reader) ([]types.ComponentRequest, error)
+
+// parsers maps manifest file names to their parser.
+var parsers = map[string]Parser{
+	"package.json":      ParsePackageJSON,
+	"package-lock.json": ParsePackageLock,
+	"requirements.txt":  ParseRequirements,
+	"pom.xml":           ParsePom,
+	"go.mod":            ParseGoMod,
+	"Gemfile":           ParseGemfile,
+	"Cargo.toml":        ParseCargoToml,
+	"composer.json":     ParseComposerJSON,
+}
+
+// ParserFor returns the parser for the given manifest file name (only the base name is used).
+func ParserFor(filename string) (Parser, bool) {
+	name := filepath.Base(filename)
+	if parser, ok := parsers[name]; ok {
+		return parser, true
+	}
+	if strings.EqualFold(filepath.Ext(name), ".csproj") {
+		return ParseCsproj, true
+	}
+	return nil, false
+}
+
+// Parse parses a manifest using the parser matching the given file name.
+func Parse(filename string, r io.Reader) ([]types.ComponentRequest, error) {
+	parser, ok := ParserFor(filename)
+	if !ok {
+		return nil, fmt.Errorf("%w: %v", ErrUnsupportedManifest, filepath.Base(filename))
+	}
+	reqs, err := parser(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %v: %w", filepath.Base(filename), err)
+	}
+	return reqs, nil
+}
+
+// ParseFile opens and parses the given manifest file.
+func ParseFile(path string) ([]types.ComponentRequest, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = f.Close() }()
+	return Parse(path, f)
+}
+
+// collector accumulates component requests, ignoring duplicate purls.
+type collector struct {
+	reqs []types.ComponentRequest
+	seen map[string]bool
+}
+
+// add adds a component request, unless its purl has already been added.
+func (c *collector) add(purl, requirement string) {
+	if c.seen == nil {
+		c.seen = make(map[string]bool)
+	}
+	if c.seen[purl] {
+		return
+	}
+	c.seen[purl] = true
+	c.reqs = append(c.reqs, types.ComponentRequest{Purl: purl, Requirement: strings.TrimSpace(requirement)})
+}
@@ -0,0 +1,197 @@
+// SPDX-License-Identifier: GPL-2.0-or-later
+/*
+ * Copyright (C) 2026 SCANOSS.COM
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 2 of the License, or
+ * (at your option) any later version.
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package manifest
+
+import (
+	"errors"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/scanoss/go-models/pkg/types"
+)
+
+func TestParseFile(t *testing.T) {
+	tests := []struct {
+		file string
+		want []types.ComponentRequest
+	}{
+		{
+			file: "testdata/package.json",
+			want: []types.ComponentRequest{
+				{Purl: "pkg:npm/%40angular/core", Requirement: "~17.1.0"},
+				{Purl: "pkg:npm/electron-updater", Requirement: "^4.0.0"},
+				{Purl: "pkg:npm/lodash", Requirement: "4.17.21"},
+				{Purl: "pkg:npm/react", Requirement: "^15.0.0"},
+				{Purl: "pkg:npm/jest", Requirement: ">=29 <30"},
+				{Purl: "pkg:npm/typescript"},
+			},
+		},
+		{
+			file: "testdata/package-lock.json",
+			want: []types.ComponentRequest{
+				{Purl: "pkg:npm/%40angular/core@17.1.2"},
+				{Purl: "pkg:npm/electron-updater@4.0.8"},
+				{Purl: "pkg:npm/react@15.7.0"},
+				{Purl: "pkg:npm/loose-envify@1.4.0"},
+			},
+		},
+		{
+			file: "testdata/requirements.txt",
+			want: []types.ComponentRequest{
+				{Purl: "pkg:pypi/requests", Requirement: "=2.31.0"},
+				{Purl: "pkg:pypi/grpcio", Requirement: ">=1.50, <2.0"},
+				{Purl: "pkg:pypi/protobuf", Requirement: ">=4.21, <5"},
+				{Purl: "pkg:pypi/flask-login", Requirement: "0.6.*"},
+				{Purl: "pkg:pypi/crc32c"},
+				{Purl: "pkg:pypi/numpy", Requirement: ">=1.24"},
+			},
+		},
+		{
+			file: "testdata/pom.xml",
+			want: []types.ComponentRequest{
+				{Purl: "pkg:maven/com.google.guava/guava", Requirement: "32.1.3-jre"},
+				{Purl: "pkg:maven/org.example/sibling", Requirement: "2.0.0"},
+				{Purl: "pkg:maven/junit/junit", Requirement: ">=4.12, <5.0"},
+				{Purl: "pkg:maven/org.slf4j/slf4j-api"},
+				{Purl: "pkg:maven/org.unknown/unknown"},
+			},
+		},
+		{
+			file: "testdata/Gemfile",
+			want: []types.ComponentRequest{
+				{Purl: "pkg:gem/rails", Requirement: ">=7.0.4, <7.1"},
+				{Purl: "pkg:gem/tablestyle", Requirement: ">=0.0.10, <1.0"},
+				{Purl: "pkg:gem/pg"},
+				{Purl: "pkg:gem/puma", Requirement: ">=6, <7"},
+				{Purl: "pkg:gem/rspec", Requirement: "3.12.0"},
+			},
+		},
+		{
+			file: "testdata/Cargo.toml",
+			want: []types.ComponentRequest{
+				{Purl: "pkg:cargo/serde", Requirement: "^1.0"},
+				{Purl: "pkg:cargo/regex", Requirement: "^1.10.2"},
+				{Purl: "pkg:cargo/rand_core", Requirement: "~0.6"},
+				{Purl: "pkg:cargo/log", Requirement: "=0.4.20"},
+				{Purl: "pkg:cargo/criterion", Requirement: ">=0.5, <0.6"},
+				{Purl: "pkg:cargo/libc", Requirement: "^0.2"},
+			},
+		},
+		{
+			file: "testdata/composer.json",
+			want: []types.ComponentRequest{
+				{Purl: "pkg:composer/symfony/console", Requirement: ">=6.3, <7 || ^7.0"},
+				{Purl: "pkg:composer/guzzlehttp/guzzle", Requirement: ">=7.0, <8.0"},
+				{Purl: "pkg:composer/monolog/monolog", Requirement: "^3.0"},
+				{Purl: "pkg:composer/vendor/branch"},
+				{Purl: "pkg:composer/vendor/stable", Requirement: "1.2.*"},
+				{Purl: "pkg:composer/phpunit/phpunit", Requirement: ">=10.5.1, <10.6"},
+			},
+		},
+		{
+			file: "testdata/Demo.csproj",
+			want: []types.ComponentRequest{
+				{Purl: "pkg:nuget/Newtonsoft.Json", Requirement: ">=13.0.3"},
+				{Purl: "pkg:nuget/Serilog", Requirement: ">=3.0, <4.0"},
+				{Purl: "pkg:nuget/Dapper", Requirement: "2.1.*"},
+				{Purl: "pkg:nuget/xunit", Requirement: "=2.6.1"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.file, func(t *testing.T) {
+			got, err := ParseFile(tt.file)
+			if err != nil {
+				t.Fatalf("ParseFile() unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseFile() = %+v\nwant %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseGoMod(t *testing.T) {
+	f, err := os.Open("testdata/go.mod.txt")
+	if err != nil {
+		t.Fatalf("failed to open go.mod fixture: %v", err)
+	}
+	defer func() { _ = f.Close() }()
+	got, err := Parse("go.mod", f)
+	if err != nil {
+		t.Fatalf("Parse() unexpected error: %v", err)
+	}
+	want := []types.ComponentRequest{
+		{Purl: "pkg:golang/github.com/Masterminds/semver/v3", Requirement: "v3.4.0"},
+		{Purl: "pkg:golang/github.com/jmoiron/sqlx", Requirement: "v1.4.0"},
+		{Purl: "pkg:golang/golang.org/x/sys", Requirement: "v0.37.0"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Parse() = %+v\nwant %+v", got, want)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	if _, err := Parse("build.gradle", strings.NewReader("")); !errors.Is(err, ErrUnsupportedManifest) {
+		t.Errorf("Parse() expected ErrUnsupportedManifest, got %v", err)
+	}
+	if _, err := ParseFile("testdata/missing/package.json"); err == nil {
+		t.Errorf("ParseFile() expected an error for a missing file")
+	}
+	for _, name := range []string{"package.json", "package-lock.json", "composer.json", "pom.xml", "App.csproj"} {
+		if _, err := Parse(name, strings.NewReader("{<not valid")); err == nil {
+			t.Errorf("Parse(%v) expected an error for invalid content", name)
+		}
+	}
+	if _, ok := ParserFor("/src/app/APP.CSPROJ"); !ok {
+		t.Errorf("ParserFor() expected a parser for .csproj files regardless of case")
+	}
+}
+
+func TestRequirementConversions(t *testing.T) {
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{name: "interval exact", got: intervalRequirement("[1.0]"), want: "=1.0"},
+		{name: "interval open lower", got: intervalRequirement("(,1.0]"), want: "<=1.0"},
+		{name: "interval open upper", got: intervalRequirement("(1.5,)"), want: ">1.5"},
+		{name: "interval union", got: intervalRequirement("[1,2),[3,4)"), want: ">=1, <2 || >=3, <4"},
+		{name: "interval plain", got: intervalRequirement("1.2.3"), want: "1.2.3"},
+		{name: "nuget bare minimum", got: nugetRequirement(" 1.2.3 "), want: ">=1.2.3"},
+		{name: "nuget interval", got: nugetRequirement("[1.0,2.0)"), want: ">=1.0, <2.0"},
+		{name: "nuget floating", got: nugetRequirement("1.*"), want: "1.*"},
+		{name: "compatible major", got: compatibleRelease("2"), want: ">=2, <3"},
+		{name: "compatible pre-release", got: compatibleRelease("1.4.beta"), want: ">=1.4.beta, <2"},
+		{name: "compatible invalid", got: compatibleRelease("abc"), want: "abc"},
+		{name: "pep440 exact", got: pep440Requirement("===1.0"), want: "=1.0"},
+		{name: "pep440 exclusion", got: pep440Requirement(">= 1.0, != 1.5"), want: ">=1.0, !=1.5"},
+		{name: "cargo star", got: cargoRequirement("*"), want: "*"},
+		{name: "composer hyphen", got: composerRequirement("1.0 - 2.0"), want: "1.0 - 2.0"},
+		{name: "composer or", got: composerRequirement("^1.0|^2.0"), want: "^1.0 || ^2.0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %q, want %q", tt.got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,109 @@
+// SPDX-License-Identifier: GPL-2.0-or-later
+/*
+ * Copyright (C) 2026 SCANOSS.COM
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 2 of the License, or
+ * (at your option) any later version.
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package manifest
+
+import (
+	"encoding/xml"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/scanoss/go-models/pkg/helpers"
+	"github.com/scanoss/go-models/pkg/types"
+)
+
+var pomPropertyRegex = regexp.MustCompile(`\$\{([^}]+)\}`) // regex to match a Maven property reference
+
+// pomProject represents the parts of a Maven pom.xml file needed to list its dependencies.
+type pomProject struct {
+	GroupID string `xml:"groupId"`
+	Version string `xml:"version"`
+	Parent  struct {
+		GroupID string `xml:"groupId"`
+		Version string `xml:"version"`
+	} `xml:"parent"`
+	Properties struct {
+		Entries []struct {
+			XMLName xml.Name
+			Value   string `xml:",chardata"`
+		} `xml:",any"`
+	} `xml:"properties"`
+	Dependencies []pomDependency `xml:"dependencies>dependency"`
+}
+
+// pomDependency represents a dependency in a Maven pom.xml file.
+type pomDependency struct {
+	GroupID    string `xml:"groupId"`
+	ArtifactID string `xml:"artifactId"`
+	Version    string `xml:"version"`
+	Scope      string `xml:"scope"`
+}
+
+// ParsePom parses the direct dependencies of a Maven pom.xml file.
+// Property references are resolved from the pom properties and project coordinates, while versions
+// managed elsewhere (e.g. by a parent pom) are returned without a requirement.
+func ParsePom(r io.Reader) ([]types.ComponentRequest, error) {
+	var pom pomProject
+	if err := xml.NewDecoder(r).Decode(&pom); err != nil {
+		return nil, err
+	}
+	props := map[string]string{
+		"project.groupId":        firstNonEmpty(pom.GroupID, pom.Parent.GroupID),
+		"project.version":        firstNonEmpty(pom.Version, pom.Parent.Version),
+		"project.parent.groupId": pom.Parent.GroupID,
+		"project.parent.version": pom.Parent.Version,
+	}
+	for _, p := range pom.Properties.Entries {
+		props[p.XMLName.Local] = strings.TrimSpace(p.Value)
+	}
+	var c collector
+	for _, dep := range pom.Dependencies {
+		if dep.Scope == "import" {
+			continue // a bill of materials, rather than a dependency
+		}
+		groupID, artifactID := resolveProperties(dep.GroupID, props), resolveProperties(dep.ArtifactID, props)
+		if len(groupID) == 0 || len(artifactID) == 0 || strings.Contains(groupID+artifactID, "${") {
+			continue
+		}
+		version := resolveProperties(dep.Version, props)
+		if strings.Contains(version, "${") {
+			version = "" // unresolved property
+		}
+		c.add(helpers.PurlString("maven", groupID+"/"+artifactID, ""), intervalRequirement(version))
+	}
+	return c.reqs, nil
+}
+
+// resolveProperties replaces the Maven property references in the given value.
+func resolveProperties(value string, props map[string]string) string {
+	return strings.TrimSpace(pomPropertyRegex.ReplaceAllStringFunc(value, func(ref string) string {
+		if v, ok := props[ref[2:len(ref)-1]]; ok && len(v) > 0 {
+			return v
+		}
+		return ref
+	}))
+}
+
+// firstNonEmpty returns the first non-empty value.
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if len(v) > 0 {
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,137 @@
+// SPDX-License-Identifier: GPL-2.0-or-later
+/*
+ * Copyright (C) 2026 SCANOSS.COM
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 2 of the License, or
+ * (at your option) any later version.
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package manifest
+
+import (
+	"encoding/json"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/scanoss/go-models/pkg/helpers"
+	"github.com/scanoss/go-models/pkg/types"
+)
+
+// packageJSON represents the dependency sections of an npm package.json file.
+type packageJSON struct {
+	Dependencies         map[string]string `json:"dependencies"`
+	DevDependencies      map[string]string `json:"devDependencies"`
+	PeerDependencies     map[string]string `json:"peerDependencies"`
+	OptionalDependencies map[string]string `json:"optionalDependencies"`
+}
+
+// packageLock represents an npm package-lock.json file (lockfileVersion 1, 2 or 3).
+type packageLock struct {
+	Packages     map[string]lockPackage    `json:"packages"`
+	Dependencies map[string]lockDependency `json:"dependencies"`
+}
+
+// lockPackage represents an entry in the packages section of a package-lock.json file.
+type lockPackage struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	Link    bool   `json:"link"`
+}
+
+// lockDependency represents an entry in the (lockfileVersion 1) dependencies section of a package-lock.json file.
+type lockDependency struct {
+	Version      string                    `json:"version"`
+	Dependencies map[string]lockDependency `json:"dependencies"`
+}
+
+// ParsePackageJSON parses the dependencies of an npm package.json file.
+// Dependencies not from the registry (e.g. git, file or URL dependencies) are skipped.
+func ParsePackageJSON(r io.Reader) ([]types.ComponentRequest, error) {
+	var pkg packageJSON
+	if err := json.NewDecoder(r).Decode(&pkg); err != nil {
+		return nil, err
+	}
+	var c collector
+	for _, deps := range []map[string]string{pkg.Dependencies, pkg.DevDependencies, pkg.PeerDependencies, pkg.OptionalDependencies} {
+		for _, name := range sortedKeys(deps) {
+			spec := strings.TrimSpace(deps[name])
+			if strings.HasPrefix(spec, "npm:") { // alias, e.g. npm:other-package@^1.0.0
+				spec = strings.TrimPrefix(spec, "npm:")
+				if i := strings.LastIndex(spec, "@"); i > 0 {
+					name, spec = spec[:i], spec[i+1:]
+				} else {
+					name, spec = spec, ""
+				}
+			}
+			if !isRegistryVersion(spec) {
+				continue
+			}
+			if spec == "latest" {
+				spec = ""
+			}
+			c.add(helpers.PurlString("npm", name, ""), spec)
+		}
+	}
+	return c.reqs, nil
+}
+
+// ParsePackageLock parses the installed packages of an npm package-lock.json file, pinning their versions.
+func ParsePackageLock(r io.Reader) ([]types.ComponentRequest, error) {
+	var lock packageLock
+	if err := json.NewDecoder(r).Decode(&lock); err != nil {
+		return nil, err
+	}
+	var c collector
+	if len(lock.Packages) > 0 {
+		for _, path := range sortedKeys(lock.Packages) {
+			pkg := lock.Packages[path]
+			i := strings.LastIndex(path, "node_modules/")
+			if i < 0 || pkg.Link || !isRegistryVersion(pkg.Version) || len(pkg.Version) == 0 {
+				continue // the root project, a workspace link or a non-registry package
+			}
+			name := path[i+len("node_modules/"):]
+			if len(pkg.Name) > 0 {
+				name = pkg.Name
+			}
+			c.add(helpers.PurlString("npm", name, pkg.Version), "")
+		}
+		return c.reqs, nil
+	}
+	addLockDependencies(&c, lock.Dependencies)
+	return c.reqs, nil
+}
+
+// addLockDependencies adds the given lockfileVersion 1 dependencies and their nested dependencies.
+func addLockDependencies(c *collector, deps map[string]lockDependency) {
+	for _, name := range sortedKeys(deps) {
+		dep := deps[name]
+		if len(dep.Version) > 0 && isRegistryVersion(dep.Version) {
+			c.add(helpers.PurlString("npm", name, dep.Version), "")
+		}
+		addLockDependencies(c, dep.Dependencies)
+	}
+}
+
+// isRegistryVersion reports whether an npm version spec refers to the registry, rather than a git, file or URL dependency.
+func isRegistryVersion(spec string) bool {
+	return !strings.Contains(spec, ":") && !strings.Contains(spec, "/")
+}
+
+// sortedKeys returns the keys of a map in sorted order.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
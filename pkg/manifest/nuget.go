@@ -0,0 +1,58 @@
+// SPDX-License-Identifier: GPL-2.0-or-later
+/*
+ * Copyright (C) 2026 SCANOSS.COM
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 2 of the License, or
+ * (at your option) any later version.
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package manifest
+
+import (
+	"encoding/xml"
+	"io"
+	"strings"
+
+	"github.com/scanoss/go-models/pkg/helpers"
+	"github.com/scanoss/go-models/pkg/types"
+)
+
+// csproj represents the package references of a .NET project file.
+type csproj struct {
+	ItemGroups []struct {
+		PackageReferences []struct {
+			Include        string `xml:"Include,attr"`
+			Version        string `xml:"Version,attr"`
+			VersionElement string `xml:"Version"`
+		} `xml:"PackageReference"`
+	} `xml:"ItemGroup"`
+}
+
+// ParseCsproj parses the NuGet package references of a .NET .csproj file.
+// The version can be given as either an attribute or a child element. A bare version is treated as a minimum.
+func ParseCsproj(r io.Reader) ([]types.ComponentRequest, error) {
+	var project csproj
+	if err := xml.NewDecoder(r).Decode(&project); err != nil {
+		return nil, err
+	}
+	var c collector
+	for _, group := range project.ItemGroups {
+		for _, ref := range group.PackageReferences {
+			name := strings.TrimSpace(ref.Include)
+			if len(name) == 0 {
+				continue
+			}
+			version := firstNonEmpty(strings.TrimSpace(ref.Version), strings.TrimSpace(ref.VersionElement))
+			c.add(helpers.PurlString("nuget", name, ""), nugetRequirement(version))
+		}
+	}
+	return c.reqs, nil
+}
@@ -0,0 +1,94 @@
+// SPDX-License-Identifier: GPL-2.0-or-later
+/*
+ * Copyright (C) 2026 SCANOSS.COM
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 2 of the License, or
+ * (at your option) any later version.
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package manifest
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/scanoss/go-models/pkg/helpers"
+	"github.com/scanoss/go-models/pkg/types"
+)
+
+var requirementLineRegex = regexp.MustCompile(`^([A-Za-z0-9][A-Za-z0-9._-]*)\s*(?:\[[^\]]*\])?\s*(.*)$`) // regex to parse a requirements.txt line
+var pypiNameRegex = regexp.MustCompile(`[-_.]+`)                                                         // regex to normalise a PyPI package name
+
+// ParseRequirements parses a pip requirements.txt file.
+// Options (e.g. -r, -e or --index-url), and URL, path and direct references are skipped.
+func ParseRequirements(r io.Reader) ([]types.ComponentRequest, error) {
+	var c collector
+	scanner := bufio.NewScanner(r)
+	var line string
+	for scanner.Scan() {
+		text := scanner.Text()
+		if strings.HasSuffix(text, "\\") { // line continuation
+			line += strings.TrimSuffix(text, "\\")
+			continue
+		}
+		line += text
+		addRequirement(&c, line)
+		line = ""
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	addRequirement(&c, line)
+	return c.reqs, nil
+}
+
+// addRequirement adds the requirement on a single requirements.txt line (if any).
+func addRequirement(c *collector, line string) {
+	if i := strings.Index(line, "#"); i >= 0 && (i == 0 || line[i-1] == ' ' || line[i-1] == '\t') {
+		line = line[:i]
+	}
+	line, _, _ = strings.Cut(line, ";") // drop environment markers
+	line = strings.TrimSpace(line)
+	if len(line) == 0 || strings.HasPrefix(line, "-") || strings.Contains(line, "://") || strings.Contains(line, "@") {
+		return
+	}
+	m := requirementLineRegex.FindStringSubmatch(line)
+	if m == nil {
+		return
+	}
+	name := strings.ToLower(pypiNameRegex.ReplaceAllString(m[1], "-"))
+	c.add(helpers.PurlString("pypi", name, ""), pep440Requirement(m[2]))
+}
+
+// pep440Requirement converts a PEP 440 version specifier to a version constraint.
+func pep440Requirement(spec string) string {
+	var clauses []string
+	for _, clause := range strings.Split(spec, ",") {
+		clause = strings.ReplaceAll(clause, " ", "")
+		switch {
+		case len(clause) == 0:
+			continue
+		case strings.HasPrefix(clause, "~="):
+			clause = compatibleRelease(strings.TrimPrefix(clause, "~="))
+		case strings.HasPrefix(clause, "==="):
+			clause = "=" + strings.TrimPrefix(clause, "===")
+		case strings.HasPrefix(clause, "=="):
+			clause = strings.TrimPrefix(clause, "==")
+			if !strings.Contains(clause, "*") {
+				clause = "=" + clause
+			}
+		}
+		clauses = append(clauses, clause)
+	}
+	return strings.Join(clauses, ", ")
+}
@@ -0,0 +1,101 @@
+// SPDX-License-Identifier: GPL-2.0-or-later
+/*
+ * Copyright (C) 2026 SCANOSS.COM
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 2 of the License, or
+ * (at your option) any later version.
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package manifest
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var intervalRegex = regexp.MustCompile(`([\[(])([^,\[\]()]*)(,?)([^\[\]()]*)([\])])`) // regex to parse Maven/NuGet version intervals
+
+// intervalRequirement converts Maven/NuGet interval notation (e.g. "[1.0,2.0)") to a version constraint.
+// Anything else is returned unchanged.
+func intervalRequirement(spec string) string {
+	spec = strings.TrimSpace(spec)
+	if !strings.HasPrefix(spec, "[") && !strings.HasPrefix(spec, "(") {
+		return spec
+	}
+	var ranges []string
+	for _, m := range intervalRegex.FindAllStringSubmatch(spec, -1) {
+		lower, upper := strings.TrimSpace(m[2]), strings.TrimSpace(m[4])
+		if len(m[3]) == 0 { // no comma: an exact version, e.g. [1.0]
+			ranges = append(ranges, "="+lower)
+			continue
+		}
+		var bounds []string
+		if len(lower) > 0 {
+			op := ">"
+			if m[1] == "[" {
+				op = ">="
+			}
+			bounds = append(bounds, op+lower)
+		}
+		if len(upper) > 0 {
+			op := "<"
+			if m[5] == "]" {
+				op = "<="
+			}
+			bounds = append(bounds, op+upper)
+		}
+		if len(bounds) > 0 {
+			ranges = append(ranges, strings.Join(bounds, ", "))
+		}
+	}
+	return strings.Join(ranges, " || ")
+}
+
+// nugetRequirement converts a NuGet version range to a version constraint.
+// A bare version is a minimum (e.g. 1.0 becomes ">=1.0"), floating versions (e.g. 1.*) are returned unchanged,
+// and interval notation is converted by intervalRequirement.
+func nugetRequirement(spec string) string {
+	spec = strings.TrimSpace(spec)
+	if len(spec) == 0 || strings.ContainsAny(spec, "*$") {
+		return spec
+	}
+	if strings.HasPrefix(spec, "[") || strings.HasPrefix(spec, "(") {
+		return intervalRequirement(spec)
+	}
+	return ">=" + spec
+}
+
+// compatibleRelease converts a "compatible release" version (Ruby ~>, PEP 440 ~= and Composer ~)
+// to a version constraint, e.g. 1.4 becomes ">=1.4, <2" and 1.4.2 becomes ">=1.4.2, <1.5".
+func compatibleRelease(version string) string {
+	version = strings.TrimSpace(version)
+	var segments []int
+	for _, s := range strings.Split(version, ".") {
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			break // ignore any pre-release segments
+		}
+		segments = append(segments, n)
+	}
+	if len(segments) == 0 {
+		return version
+	}
+	if len(segments) > 1 {
+		segments = segments[:len(segments)-1]
+	}
+	segments[len(segments)-1]++
+	upper := make([]string, len(segments))
+	for i, n := range segments {
+		upper[i] = strconv.Itoa(n)
+	}
+	return ">=" + version + ", <" + strings.Join(upper, ".")
+}
@@ -18,8 +18,13 @@ package models
 
 import (
 	"context"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"iter"
+	"strings"
 
 	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
 	"github.com/jmoiron/sqlx"
@@ -28,55 +33,97 @@ import (
 
 // AllUrlsModel provides database access for URL information.
 type AllUrlsModel struct {
-	db *sqlx.DB
+	db     *sqlx.DB
+	schema *schemaCache
+	compat *SchemaStatus // Schema compatibility recorded by NewModels. Nil allows every query
 }
 
 // AllURL represents a row on the AllURL table.
+// Joined version and license fields are empty if the referenced row does not exist (see HasVersion and HasLicense).
 type AllURL struct {
-	Component string `db:"component"`
-	Version   string `db:"version"`
-	SemVer    string `db:"semver"`
-	License   string `db:"license"`
-	LicenseID int32  `db:"license_id"`
-	IsSpdx    bool   `db:"is_spdx"`
-	PurlName  string `db:"purl_name"`
-	MineID    int32  `db:"mine_id"`
-	URL       string `db:"-"` // Computed field, not from database
+	Component   string `db:"component"`
+	Version     string `db:"version"`
+	VersionID   int32  `db:"version_id"`
+	SemVer      string `db:"semver"`
+	HasVersion  bool   `db:"has_version"` // True if the version_id references a versions row
+	License     string `db:"license"`
+	LicenseID   int32  `db:"license_id"`
+	SPDXID      string `db:"spdx_id"`
+	IsSpdx      bool   `db:"is_spdx"`
+	HasLicense  bool   `db:"has_license"` // True if the license_id references a licenses row
+	PurlName    string `db:"purl_name"`
+	PurlType    string `db:"purl_type"`
+	MineID      int32  `db:"mine_id"`
+	Vendor      string `db:"vendor"`
+	DownloadURL string `db:"url"`          // Download URL of the package archive
+	URLHash     string `db:"url_hash"`     // MD5 of the download URL
+	PackageHash string `db:"package_hash"` // MD5 of the package archive
+	Date        string `db:"date"`         // Release date of the version (YYYY-MM-DD), if known
+	URL         string `db:"-"`            // Computed field, not from database
 }
 
+// maxValuesPerQuery limits the number of values bound in a single IN query, keeping within SQLite's variable limit.
+const maxValuesPerQuery = 500
+
+// allURLColumns selects the AllURL columns from all_urls u, joined with mines m, versions v and licenses l.
+// Nullable columns are coalesced to their zero value.
+const allURLColumns = "COALESCE(u.component, '') AS component," +
+	" COALESCE(v.version_name, '') AS version, COALESCE(u.version_id, 0) AS version_id, COALESCE(v.semver, '') AS semver," +
+	" v.id IS NOT NULL AS has_version," +
+	" COALESCE(l.license_name, '') AS license, COALESCE(l.spdx_id, '') AS spdx_id, COALESCE(l.is_spdx, false) AS is_spdx," +
+	" l.id IS NOT NULL AS has_license, COALESCE(u.license_id, 0) AS license_id," +
+	" COALESCE(u.purl_name, '') AS purl_name, COALESCE(m.purl_type, '') AS purl_type, COALESCE(u.mine_id, 0) AS mine_id, COALESCE(u.vendor, '') AS vendor," +
+	" u.url, u.url_hash, u.package_hash, COALESCE(u.date, '') AS date"
+
+// allURLFrom joins all_urls u with mines m, licenses l and versions v, as required by allURLColumns.
+const allURLFrom = " FROM all_urls u" +
+	" LEFT JOIN mines m ON u.mine_id = m.id" +
+	" LEFT JOIN licenses l ON u.license_id = l.id" +
+	" LEFT JOIN versions v ON u.version_id = v.id"
+
 // NewAllURLModel creates a new instance of the AllUrlsModel.
 func NewAllURLModel(db *sqlx.DB) *AllUrlsModel {
 	return &AllUrlsModel{
-		db: db,
+		db:     db,
+		schema: newSchemaCache(db),
 	}
 }
 
 // GetURLsByPurlNameType retrieves all component URLs matching the specified PURL name and type.
 func (m *AllUrlsModel) GetURLsByPurlNameType(ctx context.Context, purlName, purlType string) ([]AllURL, error) {
+	return m.GetURLsByPurlNameTypeWithOptions(ctx, purlName, purlType, URLQueryOptions{})
+}
+
+// GetURLsByPurlNameTypeWithOptions retrieves the component URLs matching the specified PURL name and type,
+// filtered and ordered according to the given options.
+func (m *AllUrlsModel) GetURLsByPurlNameTypeWithOptions(ctx context.Context, purlName, purlType string, opts URLQueryOptions) ([]AllURL, error) {
+	if err := checkSchema(m.compat, ModelAllUrls); err != nil {
+		return nil, err
+	}
 	s := ctxzap.Extract(ctx).Sugar()
 
 	if len(purlName) == 0 {
 		s.Error("Please specify a valid Purl Name to query")
-		return nil, errors.New("please specify a valid Purl Name to query")
+		return nil, invalidInput("please specify a valid Purl Name to query")
 	}
 	if len(purlType) == 0 {
 		s.Errorf("Please specify a valid Purl Type to query: %v", purlName)
-		return nil, errors.New("please specify a valid Purl Type to query")
+		return nil, invalidInput("please specify a valid Purl Type to query")
+	}
+	if err := opts.validate(); err != nil {
+		s.Errorf("Invalid query options for %v - %v: %v", purlType, purlName, err)
+		return nil, err
 	}
 
-	query := "SELECT component, v.version_name AS version, v.semver AS semver," +
-		" l.license_name AS license, l.is_spdx AS is_spdx, u.license_id," +
-		" purl_name, mine_id FROM all_urls u" +
-		" LEFT JOIN mines m ON u.mine_id = m.id" +
-		" LEFT JOIN licenses l ON u.license_id = l.id" +
-		" LEFT JOIN versions v ON u.version_id = v.id" +
-		" WHERE m.purl_type = $1 AND u.purl_name = $2 ORDER BY date DESC"
+	filter, args := opts.where()
+	query := "SELECT " + allURLColumns + allURLFrom +
+		" WHERE m.purl_type = ? AND u.purl_name = ?" + filter + " ORDER BY date " + opts.direction()
 
 	var allUrls []AllURL
-	err := m.db.SelectContext(ctx, &allUrls, query, purlType, purlName)
+	err := m.db.SelectContext(ctx, &allUrls, m.db.Rebind(query), append([]any{purlType, purlName}, args...)...)
 	if err != nil {
 		s.Errorf("Failed to query all urls table for %v - %v: %v", purlType, purlName, err)
-		return nil, fmt.Errorf("failed to query the all urls table: %v", err)
+		return nil, dbError("failed to query the all urls table", err)
 	}
 
 	s.Debugf("Found %v results for %v, %v.", len(allUrls), purlType, purlName)
@@ -86,37 +133,438 @@ func (m *AllUrlsModel) GetURLsByPurlNameType(ctx context.Context, purlName, purl
 // GetURLsByPurlNameTypeVersion retrieves component URLs for a specific PURL name, type, and version.
 // Returns all matching results for the exact version.
 func (m *AllUrlsModel) GetURLsByPurlNameTypeVersion(ctx context.Context, purlName, purlType, purlVersion string) ([]AllURL, error) {
+	return m.GetURLsByPurlNameTypeVersionWithOptions(ctx, purlName, purlType, purlVersion, URLQueryOptions{})
+}
+
+// GetURLsByPurlNameTypeVersionWithOptions retrieves component URLs for a specific PURL name, type, and version,
+// filtered and ordered according to the given options.
+func (m *AllUrlsModel) GetURLsByPurlNameTypeVersionWithOptions(ctx context.Context, purlName, purlType, purlVersion string, opts URLQueryOptions) ([]AllURL, error) {
+	if err := checkSchema(m.compat, ModelAllUrls); err != nil {
+		return nil, err
+	}
 	s := ctxzap.Extract(ctx).Sugar()
 	if len(purlName) == 0 {
 		s.Error("Please specify a valid Purl Name to query")
-		return nil, errors.New("please specify a valid Purl Name to query")
+		return nil, invalidInput("please specify a valid Purl Name to query")
 	}
 	if len(purlType) == 0 {
 		s.Error("Please specify a valid Purl Type to query")
-		return nil, errors.New("please specify a valid Purl Type to query")
+		return nil, invalidInput("please specify a valid Purl Type to query")
 	}
 	if len(purlVersion) == 0 {
 		s.Error("Please specify a valid Purl Version to query")
-		return nil, errors.New("please specify a valid Purl Version to query")
+		return nil, invalidInput("please specify a valid Purl Version to query")
+	}
+	if err := opts.validate(); err != nil {
+		s.Errorf("Invalid query options for %v - %v - %v: %v", purlType, purlName, purlVersion, err)
+		return nil, err
 	}
 	semverV := helpers.SemverTogglePrefix(purlVersion)
 
 	// This query is same as GetURLsByPurlNameType but adds a WHERE clause for versions
-	query := "SELECT component, v.version_name AS version, v.semver AS semver," +
-		" l.license_name AS license, l.is_spdx AS is_spdx, u.license_id," +
-		" purl_name, mine_id FROM all_urls u" +
-		" LEFT JOIN mines m ON u.mine_id = m.id" +
-		" LEFT JOIN licenses l ON u.license_id = l.id" +
-		" LEFT JOIN versions v ON u.version_id = v.id" +
-		" WHERE m.purl_type = $1 AND u.purl_name = $2 AND (v.version_name = $3  OR v.version_name = $4) ORDER BY date DESC"
+	filter, args := opts.where()
+	query := "SELECT " + allURLColumns + allURLFrom +
+		" WHERE m.purl_type = ? AND u.purl_name = ? AND (v.version_name = ? OR v.version_name = ?)" + filter +
+		" ORDER BY date " + opts.direction()
 
 	var allUrls []AllURL
-	err := m.db.SelectContext(ctx, &allUrls, query, purlType, purlName, purlVersion, semverV)
+	err := m.db.SelectContext(ctx, &allUrls, m.db.Rebind(query), append([]any{purlType, purlName, purlVersion, semverV}, args...)...)
 	if err != nil {
 		s.Errorf("Failed to query all urls table for %v - %v - %v: %v", purlType, purlName, purlVersion, err)
-		return nil, fmt.Errorf("failed to query the all urls table: %v", err)
+		return nil, dbError("failed to query the all urls table", err)
 	}
 
 	s.Debugf("Found %v results for %v, %v, %v.", len(allUrls), purlType, purlName, purlVersion)
 	return allUrls, nil
 }
+
+// GetURLByPurlNameTypeVersionID retrieves the first component URL of a version (by version ID) for a PURL name and type,
+// filtered and ordered according to the given options (i.e. the newest artifact by default).
+// Returns ErrNotFound if no URL matches.
+func (m *AllUrlsModel) GetURLByPurlNameTypeVersionID(ctx context.Context, purlName, purlType string, versionID int32, opts URLQueryOptions) (AllURL, error) {
+	if err := checkSchema(m.compat, ModelAllUrls); err != nil {
+		return AllURL{}, err
+	}
+	s := ctxzap.Extract(ctx).Sugar()
+	if len(purlName) == 0 {
+		s.Error("Please specify a valid Purl Name to query")
+		return AllURL{}, invalidInput("please specify a valid Purl Name to query")
+	}
+	if len(purlType) == 0 {
+		s.Error("Please specify a valid Purl Type to query")
+		return AllURL{}, invalidInput("please specify a valid Purl Type to query")
+	}
+	if err := opts.validate(); err != nil {
+		s.Errorf("Invalid query options for %v - %v - %v: %v", purlType, purlName, versionID, err)
+		return AllURL{}, err
+	}
+	filter, args := opts.where()
+	dir := opts.direction()
+	query := "SELECT " + allURLColumns + allURLFrom +
+		" WHERE m.purl_type = ? AND u.purl_name = ? AND u.version_id = ?" + filter +
+		" ORDER BY date " + dir + ", u.url_hash " + dir + " LIMIT 1"
+
+	var allURL AllURL
+	err := m.db.QueryRowxContext(ctx, m.db.Rebind(query), append([]any{purlType, purlName, versionID}, args...)...).StructScan(&allURL)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			s.Debugf("No URL found for %v, %v, version ID %v", purlType, purlName, versionID)
+			return AllURL{}, fmt.Errorf("%w: no URL for %v %v version ID %v", ErrNotFound, purlType, purlName, versionID)
+		}
+		s.Errorf("Failed to query all urls table for %v - %v - %v: %v", purlType, purlName, versionID, err)
+		return AllURL{}, dbError("failed to query the all urls table", err)
+	}
+	return allURL, nil
+}
+
+// GetURLsByPackageHash retrieves the component URLs whose package archive has the given MD5 hash.
+func (m *AllUrlsModel) GetURLsByPackageHash(ctx context.Context, hash string) ([]AllURL, error) {
+	if len(strings.TrimSpace(hash)) == 0 {
+		ctxzap.Extract(ctx).Sugar().Error("Please specify a valid Package Hash to query")
+		return nil, invalidInput("please specify a valid Package Hash to query")
+	}
+	return m.GetURLsByPackageHashes(ctx, []string{hash})
+}
+
+// GetURLsByURLHash retrieves the component URLs whose download URL has the given MD5 hash.
+func (m *AllUrlsModel) GetURLsByURLHash(ctx context.Context, hash string) ([]AllURL, error) {
+	if len(strings.TrimSpace(hash)) == 0 {
+		ctxzap.Extract(ctx).Sugar().Error("Please specify a valid URL Hash to query")
+		return nil, invalidInput("please specify a valid URL Hash to query")
+	}
+	return m.GetURLsByURLHashes(ctx, []string{hash})
+}
+
+// GetURLsByPackageHashes retrieves the component URLs matching any of the given package archive MD5 hashes.
+// Use the PackageHash field of the results to match them to the requested hashes. Empty hashes are ignored.
+func (m *AllUrlsModel) GetURLsByPackageHashes(ctx context.Context, hashes []string) ([]AllURL, error) {
+	return m.getURLsByColumn(ctx, "package_hash", normaliseHashes(hashes))
+}
+
+// GetURLsByURLHashes retrieves the component URLs matching any of the given download URL MD5 hashes.
+// Use the URLHash field of the results to match them to the requested hashes. Empty hashes are ignored.
+func (m *AllUrlsModel) GetURLsByURLHashes(ctx context.Context, hashes []string) ([]AllURL, error) {
+	return m.getURLsByColumn(ctx, "url_hash", normaliseHashes(hashes))
+}
+
+// GetURLsByDownloadURL retrieves the component URLs with exactly the given download URL.
+func (m *AllUrlsModel) GetURLsByDownloadURL(ctx context.Context, downloadURL string) ([]AllURL, error) {
+	if len(downloadURL) == 0 {
+		ctxzap.Extract(ctx).Sugar().Error("Please specify a valid Download URL to query")
+		return nil, invalidInput("please specify a valid Download URL to query")
+	}
+	return m.getURLsByColumn(ctx, "url", []string{downloadURL})
+}
+
+// GetURLsByDownloadURLs retrieves the component URLs with exactly any of the given download URLs.
+// Use the DownloadURL field of the results to match them to the requested URLs. Empty URLs are ignored.
+func (m *AllUrlsModel) GetURLsByDownloadURLs(ctx context.Context, downloadURLs []string) ([]AllURL, error) {
+	return m.getURLsByColumn(ctx, "url", downloadURLs)
+}
+
+// getURLsByColumn retrieves the component URLs whose given column matches any of the given values.
+func (m *AllUrlsModel) getURLsByColumn(ctx context.Context, column string, values []string) ([]AllURL, error) {
+	if err := checkSchema(m.compat, ModelAllUrls); err != nil {
+		return nil, err
+	}
+	s := ctxzap.Extract(ctx).Sugar()
+	unique := make([]string, 0, len(values))
+	seen := make(map[string]bool, len(values))
+	for _, value := range values {
+		if len(value) == 0 || seen[value] {
+			continue
+		}
+		seen[value] = true
+		unique = append(unique, value)
+	}
+	var allUrls []AllURL
+	for start := 0; start < len(unique); start += maxValuesPerQuery {
+		chunk := unique[start:min(start+maxValuesPerQuery, len(unique))]
+		query, args, err := sqlx.In("SELECT "+allURLColumns+allURLFrom+
+			" WHERE u."+column+" IN (?) ORDER BY date DESC", chunk)
+		if err != nil {
+			return nil, dbError("failed to build the all urls query", err)
+		}
+		var rows []AllURL
+		if err = m.db.SelectContext(ctx, &rows, m.db.Rebind(query), args...); err != nil {
+			s.Errorf("Failed to query all urls table by %v: %v", column, err)
+			return nil, dbError("failed to query the all urls table", err)
+		}
+		allUrls = append(allUrls, rows...)
+	}
+	s.Debugf("Found %v results for %v %v values.", len(allUrls), len(unique), column)
+	return allUrls, nil
+}
+
+// normaliseHashes returns the lowercase form of the given hex hashes, as stored in the knowledge base.
+func normaliseHashes(hashes []string) []string {
+	normalised := make([]string, 0, len(hashes))
+	for _, hash := range hashes {
+		normalised = append(normalised, strings.ToLower(strings.TrimSpace(hash)))
+	}
+	return normalised
+}
+
+// Page sizes used by GetURLsByPurlNameTypePage and URLsByPurlNameType.
+const (
+	DefaultURLPageSize = 100
+	MaxURLPageSize     = 1000
+)
+
+// URLPage is a page of component URLs, ordered by release date (newest first).
+type URLPage struct {
+	URLs       []AllURL
+	NextCursor string // Pass to the next page request; empty if there are no more results
+}
+
+// urlCursor is the position of the last row of a page, in the page ordering.
+type urlCursor struct {
+	Date        string `json:"d"`
+	URLHash     string `json:"h"`
+	PackageHash string `json:"p"`
+	URL         string `json:"u"`
+}
+
+// encodeURLCursor returns the opaque cursor for the position after the given row.
+func encodeURLCursor(u AllURL) string {
+	data, _ := json.Marshal(urlCursor{Date: u.Date, URLHash: u.URLHash, PackageHash: u.PackageHash, URL: u.DownloadURL})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodeURLCursor parses an opaque cursor returned in a URLPage.
+func decodeURLCursor(cursor string) (urlCursor, error) {
+	var c urlCursor
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err == nil {
+		err = json.Unmarshal(data, &c)
+	}
+	if err != nil {
+		return urlCursor{}, invalidInput("invalid page cursor")
+	}
+	return c, nil
+}
+
+// GetURLsByPurlNameTypePage retrieves a page of the component URLs matching the specified PURL name and type.
+// Pass an empty cursor for the first page, then the NextCursor of the previous page. A limit of zero (or less)
+// uses DefaultURLPageSize, and limits above MaxURLPageSize are capped.
+func (m *AllUrlsModel) GetURLsByPurlNameTypePage(ctx context.Context, purlName, purlType, cursor string, limit int) (URLPage, error) {
+	if err := checkSchema(m.compat, ModelAllUrls); err != nil {
+		return URLPage{}, err
+	}
+	s := ctxzap.Extract(ctx).Sugar()
+	if len(purlName) == 0 {
+		s.Error("Please specify a valid Purl Name to query")
+		return URLPage{}, invalidInput("please specify a valid Purl Name to query")
+	}
+	if len(purlType) == 0 {
+		s.Error("Please specify a valid Purl Type to query")
+		return URLPage{}, invalidInput("please specify a valid Purl Type to query")
+	}
+	if limit <= 0 {
+		limit = DefaultURLPageSize
+	}
+	limit = min(limit, MaxURLPageSize)
+
+	query := "SELECT " + allURLColumns + allURLFrom + " WHERE m.purl_type = ? AND u.purl_name = ?"
+	args := []any{purlType, purlName}
+	if len(cursor) > 0 {
+		c, err := decodeURLCursor(cursor)
+		if err != nil {
+			return URLPage{}, err
+		}
+		query += " AND (COALESCE(u.date, ''), u.url_hash, u.package_hash, u.url) < (?, ?, ?, ?)"
+		args = append(args, c.Date, c.URLHash, c.PackageHash, c.URL)
+	}
+	// Order by the full primary key, so rows sharing a date have a stable position
+	query += " ORDER BY COALESCE(u.date, '') DESC, u.url_hash DESC, u.package_hash DESC, u.url DESC LIMIT ?"
+	args = append(args, limit+1)
+
+	var allUrls []AllURL
+	if err := m.db.SelectContext(ctx, &allUrls, m.db.Rebind(query), args...); err != nil {
+		s.Errorf("Failed to query all urls table for %v - %v: %v", purlType, purlName, err)
+		return URLPage{}, dbError("failed to query the all urls table", err)
+	}
+	page := URLPage{URLs: allUrls}
+	if len(allUrls) > limit {
+		page.URLs = allUrls[:limit]
+		page.NextCursor = encodeURLCursor(page.URLs[limit-1])
+	}
+	return page, nil
+}
+
+// URLsByPurlNameType streams the component URLs matching the specified PURL name and type, ordered by
+// release date (newest first). Rows are fetched a page at a time, so no connection is held between pages.
+// Iteration stops after yielding an error.
+func (m *AllUrlsModel) URLsByPurlNameType(ctx context.Context, purlName, purlType string) iter.Seq2[AllURL, error] {
+	return func(yield func(AllURL, error) bool) {
+		cursor := ""
+		for {
+			if err := ctx.Err(); err != nil {
+				yield(AllURL{}, err)
+				return
+			}
+			page, err := m.GetURLsByPurlNameTypePage(ctx, purlName, purlType, cursor, MaxURLPageSize)
+			if err != nil {
+				yield(AllURL{}, err)
+				return
+			}
+			for _, u := range page.URLs {
+				if !yield(u, nil) {
+					return
+				}
+			}
+			if len(page.NextCursor) == 0 {
+				return
+			}
+			cursor = page.NextCursor
+		}
+	}
+}
+
+// PurlVersion identifies a version of a component by its Purl Type, Name and Version.
+type PurlVersion struct {
+	PurlType string `db:"purl_type"`
+	PurlName string `db:"purl_name"`
+	Version  string `db:"version"`
+}
+
+// GetAllPurlVersions retrieves the distinct Purl Type, Name and Version of every all_urls row, in a single scan.
+// Rows without a known version are ignored.
+func (m *AllUrlsModel) GetAllPurlVersions(ctx context.Context) ([]PurlVersion, error) {
+	if err := checkSchema(m.compat, ModelAllUrls); err != nil {
+		return nil, err
+	}
+	var versions []PurlVersion
+	err := m.db.SelectContext(ctx, &versions,
+		"SELECT DISTINCT m.purl_type, u.purl_name, v.version_name AS version"+
+			" FROM all_urls u"+
+			" INNER JOIN mines m ON u.mine_id = m.id"+
+			" INNER JOIN versions v ON u.version_id = v.id"+
+			" WHERE v.version_name != '' ORDER BY m.purl_type, u.purl_name, v.version_name")
+	if err != nil {
+		ctxzap.Extract(ctx).Sugar().Errorf("Error: Failed to query all_urls table for all versions: %v", err)
+		return nil, dbError("failed to query the all_urls table", err)
+	}
+	return versions, nil
+}
+
+// URLVersion aggregates the all_urls rows (artifacts) of a single component version.
+type URLVersion struct {
+	VersionID    int32     `db:"version_id"`
+	Version      string    `db:"version"`
+	SemVer       string    `db:"semver"`
+	Artifacts    int       `db:"artifacts"`     // Number of all_urls rows for the version
+	EarliestDate string    `db:"earliest_date"` // Earliest artifact date (YYYY-MM-DD), if known
+	LatestDate   string    `db:"latest_date"`   // Latest artifact date (YYYY-MM-DD), if known
+	Licenses     []License `db:"-"`             // Distinct licenses declared across the artifacts, ordered by ID
+}
+
+// urlVersionLicense is a license declared by the artifacts of a version.
+type urlVersionLicense struct {
+	VersionID int32 `db:"version_id"`
+	License
+}
+
+// GetURLVersionsByPurlNameType retrieves one aggregated row per version of the specified PURL name and type,
+// ordered by earliest release date (newest first). Rows referencing a missing version are ignored.
+func (m *AllUrlsModel) GetURLVersionsByPurlNameType(ctx context.Context, purlName, purlType string) ([]URLVersion, error) {
+	return m.GetURLVersionsByPurlNameTypeWithOptions(ctx, purlName, purlType, URLQueryOptions{})
+}
+
+// GetURLVersionsByPurlNameTypeWithOptions retrieves one aggregated row per version of the specified PURL name and type,
+// aggregating only the rows matching the given options.
+func (m *AllUrlsModel) GetURLVersionsByPurlNameTypeWithOptions(ctx context.Context, purlName, purlType string, opts URLQueryOptions) ([]URLVersion, error) {
+	s := ctxzap.Extract(ctx).Sugar()
+	if len(purlName) == 0 {
+		s.Error("Please specify a valid Purl Name to query")
+		return nil, invalidInput("please specify a valid Purl Name to query")
+	}
+	if len(purlType) == 0 {
+		s.Error("Please specify a valid Purl Type to query")
+		return nil, invalidInput("please specify a valid Purl Type to query")
+	}
+	return m.getURLVersions(ctx, opts, "m.purl_type = ? AND u.purl_name = ?", purlType, purlName)
+}
+
+// GetURLVersionsByPurlNameTypeVersion retrieves the aggregated row of a specific version of the PURL name and type.
+// As with GetURLsByPurlNameTypeVersion, the version is matched with and without a semver "v" prefix.
+func (m *AllUrlsModel) GetURLVersionsByPurlNameTypeVersion(ctx context.Context, purlName, purlType, purlVersion string) ([]URLVersion, error) {
+	return m.GetURLVersionsByPurlNameTypeVersionWithOptions(ctx, purlName, purlType, purlVersion, URLQueryOptions{})
+}
+
+// GetURLVersionsByPurlNameTypeVersionWithOptions retrieves the aggregated row of a specific version of the PURL name and type,
+// aggregating only the rows matching the given options.
+func (m *AllUrlsModel) GetURLVersionsByPurlNameTypeVersionWithOptions(ctx context.Context, purlName, purlType, purlVersion string, opts URLQueryOptions) ([]URLVersion, error) {
+	s := ctxzap.Extract(ctx).Sugar()
+	if len(purlName) == 0 {
+		s.Error("Please specify a valid Purl Name to query")
+		return nil, invalidInput("please specify a valid Purl Name to query")
+	}
+	if len(purlType) == 0 {
+		s.Error("Please specify a valid Purl Type to query")
+		return nil, invalidInput("please specify a valid Purl Type to query")
+	}
+	if len(purlVersion) == 0 {
+		s.Error("Please specify a valid Purl Version to query")
+		return nil, invalidInput("please specify a valid Purl Version to query")
+	}
+	return m.getURLVersions(ctx, opts, "m.purl_type = ? AND u.purl_name = ? AND (v.version_name = ? OR v.version_name = ?)",
+		purlType, purlName, purlVersion, helpers.SemverTogglePrefix(purlVersion))
+}
+
+// getURLVersions aggregates the all_urls rows matching the given condition and options per version, along with their licenses.
+func (m *AllUrlsModel) getURLVersions(ctx context.Context, opts URLQueryOptions, where string, args ...any) ([]URLVersion, error) {
+	if err := checkSchema(m.compat, ModelAllUrls); err != nil {
+		return nil, err
+	}
+	s := ctxzap.Extract(ctx).Sugar()
+	if err := opts.validate(); err != nil {
+		s.Errorf("Invalid query options: %v", err)
+		return nil, err
+	}
+	filter, filterArgs := opts.where()
+	where += filter
+	args = append(args, filterArgs...)
+	dir := opts.direction()
+	var versions []URLVersion
+	err := m.db.SelectContext(ctx, &versions, m.db.Rebind(
+		"SELECT v.id AS version_id, v.version_name AS version, COALESCE(v.semver, '') AS semver, count(*) AS artifacts,"+
+			" COALESCE(MIN(u.date), '') AS earliest_date, COALESCE(MAX(u.date), '') AS latest_date"+
+			" FROM all_urls u"+
+			" INNER JOIN mines m ON u.mine_id = m.id"+
+			" INNER JOIN versions v ON u.version_id = v.id"+
+			" LEFT JOIN licenses l ON u.license_id = l.id"+
+			" WHERE "+where+
+			" GROUP BY v.id, v.version_name, v.semver ORDER BY earliest_date "+dir+", v.id "+dir), args...)
+	if err != nil {
+		s.Errorf("Failed to aggregate all urls table versions: %v", err)
+		return nil, dbError("failed to query the all urls table", err)
+	}
+	if len(versions) == 0 {
+		return versions, nil
+	}
+	var licenses []urlVersionLicense
+	err = m.db.SelectContext(ctx, &licenses, m.db.Rebind(
+		"SELECT DISTINCT u.version_id AS version_id, l.id, COALESCE(l.license_name, '') AS license_name,"+
+			" COALESCE(l.spdx_id, '') AS spdx_id, COALESCE(l.is_spdx, false) AS is_spdx, "+
+			optionalColumn(m.schema.get(ctx), "licenses", "l", "is_sanitized", "false")+
+			" FROM all_urls u"+
+			" INNER JOIN mines m ON u.mine_id = m.id"+
+			" INNER JOIN versions v ON u.version_id = v.id"+
+			" INNER JOIN licenses l ON u.license_id = l.id"+
+			" WHERE "+where+" ORDER BY l.id"), args...)
+	if err != nil {
+		s.Errorf("Failed to query all urls table version licenses: %v", err)
+		return nil, dbError("failed to query the all urls table", err)
+	}
+	index := make(map[int32]int, len(versions))
+	for i, v := range versions {
+		index[v.VersionID] = i
+	}
+	for _, l := range licenses {
+		if i, ok := index[l.VersionID]; ok {
+			versions[i].Licenses = append(versions[i].Licenses, l.License)
+		}
+	}
+	return versions, nil
+}
@@ -0,0 +1,422 @@
+// SPDX-License-Identifier: GPL-2.0-or-later
+/*
+ * Copyright (C) 2026 SCANOSS.COM
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 2 of the License, or
+ * (at your option) any later version.
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
+	"github.com/scanoss/go-models/internal/testutils"
+	zlog "github.com/scanoss/zap-logging-helper/pkg/logger"
+)
+
+func TestAllUrlsDanglingIDs(t *testing.T) {
+	err := zlog.NewSugaredDevLogger()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a sugared logger", err)
+	}
+	defer zlog.SyncZap()
+	ctx := ctxzap.ToContext(context.Background(), zlog.L)
+	db := testutils.SqliteSetup(t) // Setup SQL Lite DB
+	defer testutils.CloseDB(t, db)
+	testutils.LoadMockSQLData(t, db, "../../internal/testutils/mock")
+	testutils.LoadSQLDataFile(t, db, "../../internal/testutils/mock/dangling_ids.sql")
+
+	allUrlsModel := NewAllURLModel(db)
+	allUrls, err := allUrlsModel.GetURLsByPurlNameType(ctx, "dangling-ids", "npm")
+	if err != nil {
+		t.Fatalf("allUrls.GetURLsByPurlNameType() error = %v", err)
+	}
+	fmt.Printf("All URLs: %#v\n", allUrls)
+	if len(allUrls) != 2 {
+		t.Fatalf("allUrls.GetURLsByPurlNameType() results = %v, want 2", len(allUrls))
+	}
+	// Ordered by date descending: the 2.0.0 row references a missing version and has no license or component
+	missing, dangling := allUrls[0], allUrls[1]
+	if missing.HasVersion || len(missing.Version) > 0 || missing.HasLicense || missing.LicenseID != 0 || len(missing.Component) > 0 {
+		t.Errorf("allUrls.GetURLsByPurlNameType() unexpected row with missing joins: %#v", missing)
+	}
+	if !dangling.HasVersion || dangling.Version != "0.14.6" || dangling.SemVer != "v0.14.6" {
+		t.Errorf("allUrls.GetURLsByPurlNameType() unexpected version: %#v", dangling)
+	}
+	if dangling.HasLicense || len(dangling.License) > 0 || dangling.LicenseID != 77777777 {
+		t.Errorf("allUrls.GetURLsByPurlNameType() unexpected license for a dangling license id: %#v", dangling)
+	}
+	if dangling.Date != "2024-01-10" || dangling.Vendor != "dangling" || dangling.PackageHash != "d4a1e0c1d4a1e0c1d4a1e0c1d4a1e0c1" ||
+		dangling.URLHash != "d4a1e0c1d4a1e0c1d4a1e0c1d4a1e0c2" || dangling.DownloadURL != "https://registry.npmjs.org/dangling-ids/-/dangling-ids-1.0.0.tgz" {
+		t.Errorf("allUrls.GetURLsByPurlNameType() unexpected artifact details: %#v", dangling)
+	}
+
+	allUrls, err = allUrlsModel.GetURLsByPurlNameTypeVersion(ctx, "dangling-ids", "npm", "0.14.6")
+	if err != nil {
+		t.Fatalf("allUrls.GetURLsByPurlNameTypeVersion() error = %v", err)
+	}
+	if len(allUrls) != 1 || allUrls[0].HasLicense {
+		t.Errorf("allUrls.GetURLsByPurlNameTypeVersion() unexpected results: %#v", allUrls)
+	}
+
+	allUrls, err = allUrlsModel.GetURLsByPurlNameType(ctx, "tablestyle", "gem")
+	if err != nil {
+		t.Fatalf("allUrls.GetURLsByPurlNameType() error = %v", err)
+	}
+	for _, u := range allUrls {
+		if !u.HasVersion || !u.HasLicense {
+			t.Errorf("allUrls.GetURLsByPurlNameType() expected version and license to be present: %#v", u)
+		}
+	}
+}
+
+func TestAllUrlsByHash(t *testing.T) {
+	err := zlog.NewSugaredDevLogger()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a sugared logger", err)
+	}
+	defer zlog.SyncZap()
+	ctx := ctxzap.ToContext(context.Background(), zlog.L)
+	db := testutils.SqliteSetup(t) // Setup SQL Lite DB
+	defer testutils.CloseDB(t, db)
+	testutils.LoadMockSQLData(t, db, "../../internal/testutils/mock")
+
+	allUrlsModel := NewAllURLModel(db)
+	allUrls, err := allUrlsModel.GetURLsByPackageHash(ctx, "C0763F9BEE80979157D3AF7CCD0A4B95")
+	if err != nil {
+		t.Fatalf("allUrls.GetURLsByPackageHash() error = %v", err)
+	}
+	if len(allUrls) != 1 || allUrls[0].PurlName != "electron-updater" || allUrls[0].PurlType != "npm" || allUrls[0].Version != "4.0.8" {
+		t.Errorf("allUrls.GetURLsByPackageHash() unexpected results: %#v", allUrls)
+	}
+	allUrls, err = allUrlsModel.GetURLsByURLHash(ctx, "686dc352775b58652c9d9ddb2117f402")
+	if err != nil {
+		t.Fatalf("allUrls.GetURLsByURLHash() error = %v", err)
+	}
+	if len(allUrls) != 1 || allUrls[0].DownloadURL != "https://rubygems.org/downloads/tablestyle-0.0.12.gem" {
+		t.Errorf("allUrls.GetURLsByURLHash() unexpected results: %#v", allUrls)
+	}
+	if _, err = allUrlsModel.GetURLsByPackageHash(ctx, ""); !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("allUrls.GetURLsByPackageHash() error = %v, want %v", err, ErrInvalidInput)
+	}
+	if _, err = allUrlsModel.GetURLsByURLHash(ctx, ""); !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("allUrls.GetURLsByURLHash() error = %v, want %v", err, ErrInvalidInput)
+	}
+
+	// More hashes than fit in a single query
+	hashes := make([]string, 0, 2*maxValuesPerQuery)
+	for i := range 2 * maxValuesPerQuery {
+		hashes = append(hashes, fmt.Sprintf("%032x", i))
+	}
+	hashes = append(hashes, "c0763f9bee80979157d3af7ccd0a4b95", "", "c0763f9bee80979157d3af7ccd0a4b95")
+	allUrls, err = allUrlsModel.GetURLsByPackageHashes(ctx, hashes)
+	if err != nil {
+		t.Fatalf("allUrls.GetURLsByPackageHashes() error = %v", err)
+	}
+	if len(allUrls) != 1 || allUrls[0].PackageHash != "c0763f9bee80979157d3af7ccd0a4b95" {
+		t.Errorf("allUrls.GetURLsByPackageHashes() unexpected results: %#v", allUrls)
+	}
+	allUrls, err = allUrlsModel.GetURLsByURLHashes(ctx, nil)
+	if err != nil || len(allUrls) != 0 {
+		t.Errorf("allUrls.GetURLsByURLHashes() = %v, %v, want no results", allUrls, err)
+	}
+}
+
+func TestAllUrlsByDownloadURL(t *testing.T) {
+	err := zlog.NewSugaredDevLogger()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a sugared logger", err)
+	}
+	defer zlog.SyncZap()
+	ctx := ctxzap.ToContext(context.Background(), zlog.L)
+	db := testutils.SqliteSetup(t) // Setup SQL Lite DB
+	defer testutils.CloseDB(t, db)
+	testutils.LoadMockSQLData(t, db, "../../internal/testutils/mock")
+
+	allUrlsModel := NewAllURLModel(db)
+	allUrls, err := allUrlsModel.GetURLsByDownloadURL(ctx, "https://rubygems.org/downloads/tablestyle-0.0.12.gem")
+	if err != nil {
+		t.Fatalf("allUrls.GetURLsByDownloadURL() error = %v", err)
+	}
+	if len(allUrls) != 1 || allUrls[0].Version != "0.0.12" || allUrls[0].PurlType != "gem" {
+		t.Errorf("allUrls.GetURLsByDownloadURL() unexpected results: %#v", allUrls)
+	}
+	if _, err = allUrlsModel.GetURLsByDownloadURL(ctx, ""); !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("allUrls.GetURLsByDownloadURL() error = %v, want %v", err, ErrInvalidInput)
+	}
+	allUrls, err = allUrlsModel.GetURLsByDownloadURLs(ctx, []string{
+		"https://rubygems.org/downloads/tablestyle-0.0.12.gem/", // exact matching only
+		"https://registry.npmjs.org/electron-updater/-/electron-updater-4.0.8.tgz",
+	})
+	if err != nil {
+		t.Fatalf("allUrls.GetURLsByDownloadURLs() error = %v", err)
+	}
+	if len(allUrls) != 1 || allUrls[0].PurlName != "electron-updater" {
+		t.Errorf("allUrls.GetURLsByDownloadURLs() unexpected results: %#v", allUrls)
+	}
+}
+
+func TestAllUrlsPagination(t *testing.T) {
+	err := zlog.NewSugaredDevLogger()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a sugared logger", err)
+	}
+	defer zlog.SyncZap()
+	ctx := ctxzap.ToContext(context.Background(), zlog.L)
+	db := testutils.SqliteSetup(t) // Setup SQL Lite DB
+	defer testutils.CloseDB(t, db)
+	testutils.LoadMockSQLData(t, db, "../../internal/testutils/mock")
+
+	allUrlsModel := NewAllURLModel(db)
+	all, err := allUrlsModel.GetURLsByPurlNameType(ctx, "electron-updater", "npm")
+	if err != nil {
+		t.Fatalf("allUrls.GetURLsByPurlNameType() error = %v", err)
+	}
+	// Page through every row, checking the pages are ordered and do not overlap
+	seen := make(map[string]bool)
+	cursor, pages, lastDate := "", 0, "9999-99-99"
+	for {
+		page, pageErr := allUrlsModel.GetURLsByPurlNameTypePage(ctx, "electron-updater", "npm", cursor, 50)
+		if pageErr != nil {
+			t.Fatalf("allUrls.GetURLsByPurlNameTypePage() error = %v", pageErr)
+		}
+		pages++
+		for _, u := range page.URLs {
+			key := u.PackageHash + u.DownloadURL + u.URLHash
+			if seen[key] {
+				t.Errorf("allUrls.GetURLsByPurlNameTypePage() returned %v more than once", u.DownloadURL)
+			}
+			seen[key] = true
+			if u.Date > lastDate {
+				t.Errorf("allUrls.GetURLsByPurlNameTypePage() out of order: %v after %v", u.Date, lastDate)
+			}
+			lastDate = u.Date
+		}
+		if len(page.NextCursor) == 0 {
+			break
+		}
+		cursor = page.NextCursor
+	}
+	if len(seen) != len(all) || pages != (len(all)+49)/50 {
+		t.Errorf("allUrls.GetURLsByPurlNameTypePage() returned %v rows in %v pages, want %v rows", len(seen), pages, len(all))
+	}
+
+	count := 0
+	for u, iterErr := range allUrlsModel.URLsByPurlNameType(ctx, "electron-updater", "npm") {
+		if iterErr != nil {
+			t.Fatalf("allUrls.URLsByPurlNameType() error = %v", iterErr)
+		}
+		if len(u.DownloadURL) == 0 {
+			t.Errorf("allUrls.URLsByPurlNameType() returned an empty row")
+		}
+		count++
+	}
+	if count != len(all) {
+		t.Errorf("allUrls.URLsByPurlNameType() returned %v rows, want %v", count, len(all))
+	}
+	for range allUrlsModel.URLsByPurlNameType(ctx, "electron-updater", "npm") {
+		break // stopping early must not panic
+	}
+
+	if _, err = allUrlsModel.GetURLsByPurlNameTypePage(ctx, "electron-updater", "npm", "rubbish", 10); !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("allUrls.GetURLsByPurlNameTypePage() error = %v, want %v", err, ErrInvalidInput)
+	}
+	for _, iterErr := range allUrlsModel.URLsByPurlNameType(ctx, "", "npm") {
+		if !errors.Is(iterErr, ErrInvalidInput) {
+			t.Errorf("allUrls.URLsByPurlNameType() error = %v, want %v", iterErr, ErrInvalidInput)
+		}
+	}
+	page, err := allUrlsModel.GetURLsByPurlNameTypePage(ctx, "electron-updater", "npm", "", 0)
+	if err != nil || len(page.URLs) != min(len(all), DefaultURLPageSize) {
+		t.Errorf("allUrls.GetURLsByPurlNameTypePage() default page = %v rows, %v", len(page.URLs), err)
+	}
+}
+
+func TestAllUrlsVersions(t *testing.T) {
+	err := zlog.NewSugaredDevLogger()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a sugared logger", err)
+	}
+	defer zlog.SyncZap()
+	ctx := ctxzap.ToContext(context.Background(), zlog.L)
+	db := testutils.SqliteSetup(t) // Setup SQL Lite DB
+	defer testutils.CloseDB(t, db)
+	testutils.LoadMockSQLData(t, db, "../../internal/testutils/mock")
+	testutils.LoadSQLDataFile(t, db, "../../internal/testutils/mock/multi_artifact.sql")
+	if _, err = db.Exec("UPDATE licenses SET is_sanitized = true WHERE spdx_id = 'MIT'"); err != nil {
+		t.Fatalf("failed to update licenses table: %v", err)
+	}
+
+	allUrlsModel := NewAllURLModel(db)
+	versions, err := allUrlsModel.GetURLVersionsByPurlNameType(ctx, "multi-artifact", "npm")
+	if err != nil {
+		t.Fatalf("allUrls.GetURLVersionsByPurlNameType() error = %v", err)
+	}
+	fmt.Printf("Versions: %#v\n", versions)
+	if len(versions) != 2 || versions[0].Version != "2.0.0" || versions[1].Version != "1.0.0" {
+		t.Fatalf("allUrls.GetURLVersionsByPurlNameType() unexpected versions: %#v", versions)
+	}
+	v := versions[1]
+	if v.VersionID != 400387 || v.Artifacts != 3 || v.EarliestDate != "2024-03-01" || v.LatestDate != "2024-03-05" {
+		t.Errorf("allUrls.GetURLVersionsByPurlNameType() unexpected aggregate: %#v", v)
+	}
+	if len(v.Licenses) != 2 || v.Licenses[0].SPDX != "Apache-2.0" || v.Licenses[1].SPDX != "MIT" {
+		t.Fatalf("allUrls.GetURLVersionsByPurlNameType() unexpected licenses: %#v", v.Licenses)
+	}
+	if v.Licenses[0].IsSanitized || !v.Licenses[1].IsSanitized {
+		t.Errorf("allUrls.GetURLVersionsByPurlNameType() unexpected is_sanitized: %#v", v.Licenses)
+	}
+	if versions[0].Artifacts != 1 || len(versions[0].Licenses) != 1 {
+		t.Errorf("allUrls.GetURLVersionsByPurlNameType() unexpected aggregate: %#v", versions[0])
+	}
+
+	versions, err = allUrlsModel.GetURLVersionsByPurlNameTypeVersion(ctx, "multi-artifact", "npm", "v1.0.0")
+	if err != nil {
+		t.Fatalf("allUrls.GetURLVersionsByPurlNameTypeVersion() error = %v", err)
+	}
+	if len(versions) != 1 || versions[0].Artifacts != 3 {
+		t.Errorf("allUrls.GetURLVersionsByPurlNameTypeVersion() unexpected versions: %#v", versions)
+	}
+
+	// One row per version, rather than one per artifact
+	versions, err = allUrlsModel.GetURLVersionsByPurlNameType(ctx, "grpcio", "pypi")
+	if err != nil {
+		t.Fatalf("allUrls.GetURLVersionsByPurlNameType() error = %v", err)
+	}
+	if len(versions) != 170 {
+		t.Errorf("allUrls.GetURLVersionsByPurlNameType() results = %v, want 170", len(versions))
+	}
+	versions, err = allUrlsModel.GetURLVersionsByPurlNameType(ctx, "does-not-exist", "npm")
+	if err != nil || len(versions) != 0 {
+		t.Errorf("allUrls.GetURLVersionsByPurlNameType() = %v, %v, want no versions", versions, err)
+	}
+	if _, err = allUrlsModel.GetURLVersionsByPurlNameTypeVersion(ctx, "multi-artifact", "npm", ""); !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("allUrls.GetURLVersionsByPurlNameTypeVersion() error = %v, want %v", err, ErrInvalidInput)
+	}
+}
+
+func TestAllUrlsAllPurlVersions(t *testing.T) {
+	err := zlog.NewSugaredDevLogger()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a sugared logger", err)
+	}
+	defer zlog.SyncZap()
+	ctx := ctxzap.ToContext(context.Background(), zlog.L)
+	db := testutils.SqliteSetup(t) // Setup SQL Lite DB
+	defer testutils.CloseDB(t, db)
+	testutils.LoadMockSQLData(t, db, "../../internal/testutils/mock")
+	testutils.LoadSQLDataFile(t, db, "../../internal/testutils/mock/multi_artifact.sql")
+
+	allUrlsModel := NewAllURLModel(db)
+	versions, err := allUrlsModel.GetAllPurlVersions(ctx)
+	if err != nil {
+		t.Fatalf("all_urls.GetAllPurlVersions() error = %v", err)
+	}
+	var multiArtifact []string
+	for _, v := range versions {
+		if v.PurlType == "npm" && v.PurlName == "multi-artifact" {
+			multiArtifact = append(multiArtifact, v.Version)
+		}
+	}
+	fmt.Printf("multi-artifact versions: %v\n", multiArtifact)
+	if len(multiArtifact) != 2 || multiArtifact[0] > multiArtifact[1] {
+		t.Errorf("all_urls.GetAllPurlVersions() expected 2 distinct, sorted multi-artifact versions, got %v", multiArtifact)
+	}
+}
+
+func TestAllUrlsWithOptions(t *testing.T) {
+	err := zlog.NewSugaredDevLogger()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a sugared logger", err)
+	}
+	defer zlog.SyncZap()
+	ctx := ctxzap.ToContext(context.Background(), zlog.L)
+	db := testutils.SqliteSetup(t) // Setup SQL Lite DB
+	defer testutils.CloseDB(t, db)
+	testutils.LoadMockSQLData(t, db, "../../internal/testutils/mock")
+	testutils.LoadSQLDataFile(t, db, "../../internal/testutils/mock/multi_artifact.sql")
+
+	allUrlsModel := NewAllURLModel(db)
+	tests := []struct {
+		name      string
+		opts      URLQueryOptions
+		wantCount int
+		wantFirst string
+	}{
+		{name: "no options", opts: URLQueryOptions{}, wantCount: 223, wantFirst: "2021-12-01"},
+		{name: "mine id", opts: URLQueryOptions{MineIDs: []int32{2}}, wantCount: 223},
+		{name: "mine name", opts: URLQueryOptions{MineName: "npmjs.org"}, wantCount: 223},
+		{name: "other mine", opts: URLQueryOptions{MineName: "nodejs.org"}, wantCount: 0},
+		{name: "released since", opts: URLQueryOptions{DateFrom: "2020-01-01"}, wantCount: 31, wantFirst: "2021-12-01"},
+		{name: "released since, oldest first", opts: URLQueryOptions{DateFrom: "2020-01-01", Order: URLOrderDateAsc}, wantCount: 31, wantFirst: "2020-01-20"},
+		{name: "released until", opts: URLQueryOptions{DateTo: "2015-05-12", Order: URLOrderDateAsc}, wantCount: 1, wantFirst: "2015-05-12"},
+		{name: "license", opts: URLQueryOptions{LicenseID: 5614, SPDXOnly: true}, wantCount: 223},
+		{name: "other license", opts: URLQueryOptions{LicenseID: 850}, wantCount: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			allUrls, err := allUrlsModel.GetURLsByPurlNameTypeWithOptions(ctx, "electron-updater", "npm", tt.opts)
+			if err != nil {
+				t.Fatalf("allUrls.GetURLsByPurlNameTypeWithOptions() error = %v", err)
+			}
+			if len(allUrls) != tt.wantCount {
+				t.Fatalf("allUrls.GetURLsByPurlNameTypeWithOptions() results = %v, want %v", len(allUrls), tt.wantCount)
+			}
+			if len(tt.wantFirst) > 0 && allUrls[0].Date != tt.wantFirst {
+				t.Errorf("allUrls.GetURLsByPurlNameTypeWithOptions() first date = %v, want %v", allUrls[0].Date, tt.wantFirst)
+			}
+		})
+	}
+
+	// Only the SPDX licensed artifacts are aggregated
+	versions, err := allUrlsModel.GetURLVersionsByPurlNameTypeVersionWithOptions(ctx, "multi-artifact", "npm", "1.0.0", URLQueryOptions{SPDXOnly: true})
+	if err != nil {
+		t.Fatalf("allUrls.GetURLVersionsByPurlNameTypeVersionWithOptions() error = %v", err)
+	}
+	if len(versions) != 1 || versions[0].Artifacts != 2 || len(versions[0].Licenses) != 2 {
+		t.Errorf("allUrls.GetURLVersionsByPurlNameTypeVersionWithOptions() unexpected versions: %#v", versions)
+	}
+	versions, err = allUrlsModel.GetURLVersionsByPurlNameTypeWithOptions(ctx, "multi-artifact", "npm", URLQueryOptions{LicenseID: 850, Order: URLOrderDateAsc})
+	if err != nil {
+		t.Fatalf("allUrls.GetURLVersionsByPurlNameTypeWithOptions() error = %v", err)
+	}
+	if len(versions) != 1 || versions[0].Version != "1.0.0" || versions[0].EarliestDate != "2024-03-05" {
+		t.Errorf("allUrls.GetURLVersionsByPurlNameTypeWithOptions() unexpected versions: %#v", versions)
+	}
+	versions, err = allUrlsModel.GetURLVersionsByPurlNameTypeWithOptions(ctx, "multi-artifact", "npm", URLQueryOptions{Order: URLOrderDateAsc})
+	if err != nil || len(versions) != 2 || versions[0].Version != "1.0.0" {
+		t.Errorf("allUrls.GetURLVersionsByPurlNameTypeWithOptions() = %#v, %v, want oldest version first", versions, err)
+	}
+
+	latest, err := allUrlsModel.GetURLByPurlNameTypeVersionID(ctx, "multi-artifact", "npm", 400387, URLQueryOptions{})
+	if err != nil || latest.Date != "2024-03-05" || latest.Version != "1.0.0" {
+		t.Errorf("allUrls.GetURLByPurlNameTypeVersionID() = %#v, %v, want the newest artifact", latest, err)
+	}
+	oldest, err := allUrlsModel.GetURLByPurlNameTypeVersionID(ctx, "multi-artifact", "npm", 400387, URLQueryOptions{Order: URLOrderDateAsc})
+	if err != nil || oldest.Date != "2024-03-01" {
+		t.Errorf("allUrls.GetURLByPurlNameTypeVersionID() = %#v, %v, want the oldest artifact", oldest, err)
+	}
+	if _, err = allUrlsModel.GetURLByPurlNameTypeVersionID(ctx, "multi-artifact", "npm", 400387, URLQueryOptions{LicenseID: 109}); !errors.Is(err, ErrNotFound) {
+		t.Errorf("allUrls.GetURLByPurlNameTypeVersionID() error = %v, want %v", err, ErrNotFound)
+	}
+
+	if _, err = allUrlsModel.GetURLsByPurlNameTypeWithOptions(ctx, "electron-updater", "npm", URLQueryOptions{DateTo: "yesterday"}); !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("allUrls.GetURLsByPurlNameTypeWithOptions() error = %v, want %v", err, ErrInvalidInput)
+	}
+	if _, err = allUrlsModel.GetURLVersionsByPurlNameTypeWithOptions(ctx, "electron-updater", "npm", URLQueryOptions{MineIDs: []int32{-2}}); !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("allUrls.GetURLVersionsByPurlNameTypeWithOptions() error = %v, want %v", err, ErrInvalidInput)
+	}
+}
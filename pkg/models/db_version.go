@@ -19,12 +19,16 @@
 package models
 
 import (
+	"cmp"
 	"context"
 	"database/sql"
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/Masterminds/semver/v3"
 	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
 	"github.com/jmoiron/sqlx"
 )
@@ -42,6 +46,23 @@ type DBVersion struct {
 	DBRelease     string `db:"db_release"`
 }
 
+// DBRelease represents a parsed knowledge base release identifier (e.g. 2026.01).
+type DBRelease struct {
+	Year  int
+	Month int
+}
+
+// DBVersionInfo is a typed representation of a row in the db_version table.
+type DBVersionInfo struct {
+	PackageName   string
+	SchemaVersion *semver.Version
+	Release       DBRelease
+	CreatedAt     time.Time
+}
+
+// createdAtLayouts lists the accepted formats for the db_version created_at column.
+var createdAtLayouts = []string{time.RFC3339Nano, time.DateTime, time.DateOnly}
+
 // NewDBVersionModel creates a new instance of the DBVersion Model.
 func NewDBVersionModel(db *sqlx.DB) *DBVersionModel {
 	return &DBVersionModel{db: db}
@@ -58,16 +79,157 @@ func (m *DBVersionModel) GetCurrentVersion(ctx context.Context) (DBVersion, erro
 	}
 	var dbVersion DBVersion
 	err := m.db.QueryRowxContext(ctx,
-		"SELECT package_name, schema_version, created_at, db_release FROM db_version LIMIT 1").StructScan(&dbVersion)
+		"SELECT package_name, schema_version, created_at, db_release FROM db_version ORDER BY rowid LIMIT 1").StructScan(&dbVersion)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			s.Debug("No version found in db_version table")
 			return DBVersion{}, nil
 		}
-		return DBVersion{}, fmt.Errorf("failed to query db_version table: %w", err)
+		return DBVersion{}, dbError("failed to query db_version table", err)
 	}
 	if t, parseErr := time.Parse(time.RFC3339, dbVersion.CreatedAt); parseErr == nil {
 		dbVersion.CreatedAt = t.Format(time.DateOnly)
 	}
 	return dbVersion, nil
 }
+
+// GetVersions retrieves every row of the db_version table (one per package) as typed versions, ordered by package name.
+// Rows that cannot be parsed are skipped with a warning.
+// Returns ErrTableNotFound if the db_version table does not exist.
+func (m *DBVersionModel) GetVersions(ctx context.Context) ([]DBVersionInfo, error) {
+	s := ctxzap.Extract(ctx).Sugar()
+	rows, err := m.getRows(ctx)
+	if err != nil {
+		return nil, err
+	}
+	versions := make([]DBVersionInfo, 0, len(rows))
+	for _, row := range rows {
+		version, parseErr := ParseDBVersion(row)
+		if parseErr != nil {
+			s.Warnf("Skipping db_version row %#v: %v", row, parseErr)
+			continue
+		}
+		versions = append(versions, version)
+	}
+	return versions, nil
+}
+
+// getRows retrieves the raw rows of the db_version table, ordered by package name.
+// Returns ErrTableNotFound if the db_version table does not exist.
+func (m *DBVersionModel) getRows(ctx context.Context) ([]DBVersion, error) {
+	if !tableExists(ctx, m.db, "db_version") {
+		ctxzap.Extract(ctx).Sugar().Debug("db_version table does not exist")
+		return nil, ErrTableNotFound
+	}
+	var rows []DBVersion
+	err := m.db.SelectContext(ctx, &rows,
+		"SELECT package_name, schema_version, created_at, db_release FROM db_version ORDER BY package_name, rowid")
+	if err != nil {
+		return nil, dbError("failed to query db_version table", err)
+	}
+	return rows, nil
+}
+
+// GetVersionByPackage retrieves the typed db_version row for the given package name.
+// Returns ErrTableNotFound if the db_version table does not exist, and ErrNotFound if the package is not present.
+func (m *DBVersionModel) GetVersionByPackage(ctx context.Context, packageName string) (DBVersionInfo, error) {
+	versions, err := m.GetVersions(ctx)
+	if err != nil {
+		return DBVersionInfo{}, err
+	}
+	for _, version := range versions {
+		if version.PackageName == packageName {
+			return version, nil
+		}
+	}
+	ctxzap.Extract(ctx).Sugar().Debugf("No version found in db_version table for package %v", packageName)
+	return DBVersionInfo{}, fmt.Errorf("%w: no version in db_version table for package %v", ErrNotFound, packageName)
+}
+
+// ParseDBVersion converts a raw db_version row into its typed representation.
+func ParseDBVersion(v DBVersion) (DBVersionInfo, error) {
+	info := DBVersionInfo{PackageName: v.PackageName}
+	var err error
+	if info.SchemaVersion, err = semver.NewVersion(v.SchemaVersion); err != nil {
+		return DBVersionInfo{}, fmt.Errorf("invalid schema version %q for %v: %w", v.SchemaVersion, v.PackageName, err)
+	}
+	if info.Release, err = ParseDBRelease(v.DBRelease); err != nil {
+		return DBVersionInfo{}, fmt.Errorf("invalid db release for %v: %w", v.PackageName, err)
+	}
+	for _, layout := range createdAtLayouts {
+		if t, parseErr := time.Parse(layout, v.CreatedAt); parseErr == nil {
+			info.CreatedAt = t
+			return info, nil
+		}
+	}
+	return DBVersionInfo{}, fmt.Errorf("invalid created_at %q for %v", v.CreatedAt, v.PackageName)
+}
+
+// ParseDBRelease parses a release identifier in the form YYYY.MM (e.g. 2026.01).
+func ParseDBRelease(release string) (DBRelease, error) {
+	yearStr, monthStr, found := strings.Cut(strings.TrimSpace(release), ".")
+	if !found {
+		return DBRelease{}, fmt.Errorf("release %q is not in the form YYYY.MM", release)
+	}
+	year, err := strconv.Atoi(yearStr)
+	if err != nil {
+		return DBRelease{}, fmt.Errorf("release %q has an invalid year: %w", release, err)
+	}
+	month, err := strconv.Atoi(monthStr)
+	if err != nil {
+		return DBRelease{}, fmt.Errorf("release %q has an invalid month: %w", release, err)
+	}
+	if month < 1 || month > 12 {
+		return DBRelease{}, fmt.Errorf("release %q has an out of range month", release)
+	}
+	return DBRelease{Year: year, Month: month}, nil
+}
+
+// String returns the release in the form YYYY.MM.
+func (r DBRelease) String() string {
+	return fmt.Sprintf("%04d.%02d", r.Year, r.Month)
+}
+
+// Compare returns -1, 0 or +1 depending on whether r is older, the same as, or newer than o.
+func (r DBRelease) Compare(o DBRelease) int {
+	if c := cmp.Compare(r.Year, o.Year); c != 0 {
+		return c
+	}
+	return cmp.Compare(r.Month, o.Month)
+}
+
+// Before returns true if r is an older release than o.
+func (r DBRelease) Before(o DBRelease) bool {
+	return r.Compare(o) < 0
+}
+
+// After returns true if r is a newer release than o.
+func (r DBRelease) After(o DBRelease) bool {
+	return r.Compare(o) > 0
+}
+
+// Compare orders versions by release, then by creation time.
+// It returns -1, 0 or +1 depending on whether v is older, the same as, or newer than o.
+func (v DBVersionInfo) Compare(o DBVersionInfo) int {
+	if c := v.Release.Compare(o.Release); c != 0 {
+		return c
+	}
+	return v.CreatedAt.Compare(o.CreatedAt)
+}
+
+// NewerThan returns true if v is a newer release than o.
+func (v DBVersionInfo) NewerThan(o DBVersionInfo) bool {
+	return v.Compare(o) > 0
+}
+
+// SchemaSatisfies returns true if the schema version satisfies the given semver constraint (e.g. ">= 1.0.0, < 2.0.0").
+func (v DBVersionInfo) SchemaSatisfies(constraint string) (bool, error) {
+	if v.SchemaVersion == nil {
+		return false, errors.New("no schema version available")
+	}
+	c, err := semver.NewConstraint(constraint)
+	if err != nil {
+		return false, fmt.Errorf("invalid schema constraint %q: %w", constraint, err)
+	}
+	return c.Check(v.SchemaVersion), nil
+}
@@ -21,6 +21,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
 	"github.com/scanoss/go-models/internal/testutils"
@@ -116,3 +117,93 @@ func TestDBVersionGetCurrentVersionEmptyTable(t *testing.T) {
 	}
 	fmt.Printf("DBVersion (empty expected): %#v\n", version)
 }
+
+func TestDBVersionGetVersions(t *testing.T) {
+	err := zlog.NewSugaredDevLogger()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a sugared logger", err)
+	}
+	defer zlog.SyncZap()
+	ctx := ctxzap.ToContext(context.Background(), zlog.L)
+	db := testutils.SqliteSetup(t)
+	defer testutils.CloseDB(t, db)
+
+	model := NewDBVersionModel(db)
+	_, err = model.GetVersions(ctx)
+	if !errors.Is(err, ErrTableNotFound) {
+		t.Errorf("DBVersionModel.GetVersions() expected ErrTableNotFound, got %v", err)
+	}
+
+	testutils.LoadSQLDataFile(t, db, "../../internal/testutils/mock/db_version.sql")
+	versions, err := model.GetVersions(ctx)
+	if err != nil {
+		t.Fatalf("DBVersionModel.GetVersions() error = %v", err)
+	}
+	if len(versions) != 2 {
+		t.Fatalf("DBVersionModel.GetVersions() expected 2 rows, got %v", len(versions))
+	}
+	base := versions[0]
+	if base.PackageName != "base" || base.SchemaVersion.String() != "1.0.0" || base.Release.String() != "2026.01" {
+		t.Errorf("DBVersionModel.GetVersions() unexpected base version: %#v", base)
+	}
+	if !base.CreatedAt.Equal(time.Date(2026, 1, 15, 10, 30, 0, 0, time.UTC)) {
+		t.Errorf("DBVersionModel.GetVersions() created_at = %v, want 2026-01-15T10:30:00Z", base.CreatedAt)
+	}
+	licenses, err := model.GetVersionByPackage(ctx, "licenses")
+	if err != nil {
+		t.Errorf("DBVersionModel.GetVersionByPackage() error = %v", err)
+	}
+	if !licenses.NewerThan(base) || base.NewerThan(licenses) || !base.Release.Before(licenses.Release) {
+		t.Errorf("DBVersionInfo.NewerThan() expected %v to be newer than %v", licenses.Release, base.Release)
+	}
+	if ok, _ := licenses.SchemaSatisfies(">= 1.1.0"); !ok {
+		t.Errorf("DBVersionInfo.SchemaSatisfies() expected %v to satisfy >= 1.1.0", licenses.SchemaVersion)
+	}
+	if ok, _ := base.SchemaSatisfies(">= 1.1.0"); ok {
+		t.Errorf("DBVersionInfo.SchemaSatisfies() expected %v not to satisfy >= 1.1.0", base.SchemaVersion)
+	}
+	missing, err := model.GetVersionByPackage(ctx, "missing")
+	if !errors.Is(err, ErrNotFound) || len(missing.PackageName) > 0 {
+		t.Errorf("DBVersionModel.GetVersionByPackage() expected ErrNotFound, got %#v, %v", missing, err)
+	}
+
+	_, err = db.Exec("INSERT INTO db_version (package_name, schema_version, created_at, db_release) VALUES ('bad', '1.0.0', 'yesterday', '2026.03')")
+	if err != nil {
+		t.Fatalf("failed to insert db_version row: %v", err)
+	}
+	versions, err = model.GetVersions(ctx)
+	if err != nil || len(versions) != 2 {
+		t.Errorf("DBVersionModel.GetVersions() expected the unparsable row to be skipped, got %v rows, %v", len(versions), err)
+	}
+	fmt.Printf("DBVersions: %#v\n", versions)
+}
+
+func TestParseDBRelease(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    DBRelease
+		wantErr bool
+	}{
+		{name: "valid release", input: "2026.01", want: DBRelease{Year: 2026, Month: 1}},
+		{name: "single digit month", input: " 2025.9 ", want: DBRelease{Year: 2025, Month: 9}},
+		{name: "missing month", input: "2026", wantErr: true},
+		{name: "invalid month", input: "2026.13", wantErr: true},
+		{name: "invalid year", input: "abc.01", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, parseErr := ParseDBRelease(tt.input)
+			if (parseErr != nil) != tt.wantErr {
+				t.Errorf("ParseDBRelease() error = %v, wantErr %v", parseErr, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ParseDBRelease() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+	if (DBRelease{Year: 2025, Month: 12}).Compare(DBRelease{Year: 2026, Month: 1}) != -1 {
+		t.Errorf("DBRelease.Compare() expected 2025.12 to be older than 2026.01")
+	}
+}
@@ -19,6 +19,7 @@ package models
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	"github.com/jmoiron/sqlx"
 )
@@ -26,6 +27,28 @@ import (
 // ErrTableNotFound is returned when a required database table does not exist.
 var ErrTableNotFound = errors.New("table not found")
 
+// Sentinel errors returned (wrapped) by the models and services, so callers can classify failures with errors.Is.
+var (
+	// ErrInvalidInput is returned when a query is missing a required value or the value is malformed.
+	ErrInvalidInput = errors.New("invalid input")
+	// ErrNotFound is returned when the requested record does not exist.
+	ErrNotFound = errors.New("not found")
+	// ErrNoVersionMatch is returned when a component exists, but none of its versions satisfy the requirement.
+	ErrNoVersionMatch = errors.New("no version matches requirement")
+	// ErrDatabase is returned when a database query fails.
+	ErrDatabase = errors.New("database error")
+)
+
+// invalidInput returns an error wrapping ErrInvalidInput with the given message.
+func invalidInput(msg string) error {
+	return fmt.Errorf("%w: %s", ErrInvalidInput, msg)
+}
+
+// dbError returns an error wrapping both ErrDatabase and the underlying database error.
+func dbError(msg string, err error) error {
+	return fmt.Errorf("%w: %s: %w", ErrDatabase, msg, err)
+}
+
 // tableExists checks if a table exists in an SQLite database.
 // This is used for backward compatibility with databases that predate certain tables.
 func tableExists(ctx context.Context, db *sqlx.DB, tableName string) bool {
@@ -0,0 +1,77 @@
+// SPDX-License-Identifier: GPL-2.0-or-later
+/*
+ * Copyright (C) 2026 SCANOSS.COM
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 2 of the License, or
+ * (at your option) any later version.
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package models
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
+	"github.com/scanoss/go-models/internal/testutils"
+	zlog "github.com/scanoss/zap-logging-helper/pkg/logger"
+)
+
+func TestSentinelErrors(t *testing.T) {
+	err := zlog.NewSugaredDevLogger()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a sugared logger", err)
+	}
+	defer zlog.SyncZap()
+	ctx := ctxzap.ToContext(context.Background(), zlog.L)
+	db := testutils.SqliteSetupNamed(t, "sentinel_errors") // Empty database, so every query fails
+	defer testutils.CloseDB(t, db)
+
+	allUrls := NewAllURLModel(db)
+	projects := NewProjectModel(db)
+	mines := NewMineModel(db)
+
+	tests := []struct {
+		name    string
+		call    func() error
+		wantErr error
+	}{
+		{
+			name:    "all urls missing name",
+			call:    func() error { _, e := allUrls.GetURLsByPurlNameType(ctx, "", "npm"); return e },
+			wantErr: ErrInvalidInput,
+		},
+		{
+			name:    "projects missing type",
+			call:    func() error { _, e := projects.CheckPurlByNameType(ctx, "react", ""); return e },
+			wantErr: ErrInvalidInput,
+		},
+		{
+			name:    "all urls query failure",
+			call:    func() error { _, e := allUrls.GetURLsByPurlNameType(ctx, "react", "npm"); return e },
+			wantErr: ErrDatabase,
+		},
+		{
+			name:    "mines query failure",
+			call:    func() error { _, e := mines.GetMineIdsByPurlType(ctx, "npm"); return e },
+			wantErr: ErrDatabase,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			callErr := tt.call()
+			if !errors.Is(callErr, tt.wantErr) {
+				t.Errorf("error = %v, want %v", callErr, tt.wantErr)
+			}
+		})
+	}
+}
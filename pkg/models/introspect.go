@@ -0,0 +1,106 @@
+// SPDX-License-Identifier: GPL-2.0-or-later
+/*
+ * Copyright (C) 2026 SCANOSS.COM
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 2 of the License, or
+ * (at your option) any later version.
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+// Handle introspection of the tables and columns available in the database
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
+	"github.com/jmoiron/sqlx"
+)
+
+// SchemaInfo describes the tables and columns available in the connected database.
+type SchemaInfo struct {
+	Tables map[string]map[string]bool // Table name to the set of its column names
+}
+
+// HasTable returns true if the given table exists.
+func (i SchemaInfo) HasTable(table string) bool {
+	_, ok := i.Tables[table]
+	return ok
+}
+
+// HasColumn returns true if the given table exists and contains the given column.
+func (i SchemaInfo) HasColumn(table, column string) bool {
+	return i.Tables[table][column]
+}
+
+// Introspect detects the tables and columns available in an SQLite database.
+// This allows models to adapt their queries to older and newer knowledge base dumps.
+func Introspect(ctx context.Context, db *sqlx.DB) (SchemaInfo, error) {
+	var columns []struct {
+		Table  string `db:"table_name"`
+		Column string `db:"column_name"`
+	}
+	err := db.SelectContext(ctx, &columns,
+		"SELECT m.name AS table_name, p.name AS column_name FROM sqlite_master m"+
+			" JOIN pragma_table_info(m.name) p"+
+			" WHERE m.type = 'table' ORDER BY m.name, p.cid")
+	if err != nil {
+		return SchemaInfo{}, dbError("failed to introspect the database schema", err)
+	}
+	info := SchemaInfo{Tables: make(map[string]map[string]bool)}
+	for _, c := range columns {
+		if info.Tables[c.Table] == nil {
+			info.Tables[c.Table] = make(map[string]bool)
+		}
+		info.Tables[c.Table][c.Column] = true
+	}
+	return info, nil
+}
+
+// schemaCache lazily introspects and caches the database schema for a model.
+type schemaCache struct {
+	db   *sqlx.DB
+	mu   sync.Mutex
+	info *SchemaInfo
+}
+
+// newSchemaCache creates a new (empty) schema cache for the given database.
+func newSchemaCache(db *sqlx.DB) *schemaCache {
+	return &schemaCache{db: db}
+}
+
+// get returns the cached schema info, introspecting the database on first use.
+// Failures are not cached, and an empty schema is returned so that queries fall back to their defaults.
+func (c *schemaCache) get(ctx context.Context) SchemaInfo {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.info != nil {
+		return *c.info
+	}
+	info, err := Introspect(ctx, c.db)
+	if err != nil {
+		ctxzap.Extract(ctx).Sugar().Warnf("Problem introspecting database schema: %v", err)
+		return SchemaInfo{}
+	}
+	c.info = &info
+	return info
+}
+
+// optionalColumn returns the select expression for a column that may not exist in older databases.
+// If the column is missing, the fallback value is selected instead.
+func optionalColumn(info SchemaInfo, table, alias, column, fallback string) string {
+	if info.HasColumn(table, column) {
+		return fmt.Sprintf("COALESCE(%s.%s, %s) AS %s", alias, column, fallback, column)
+	}
+	return fmt.Sprintf("%s AS %s", fallback, column)
+}
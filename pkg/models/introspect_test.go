@@ -0,0 +1,101 @@
+// SPDX-License-Identifier: GPL-2.0-or-later
+/*
+ * Copyright (C) 2026 SCANOSS.COM
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 2 of the License, or
+ * (at your option) any later version.
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
+	"github.com/scanoss/go-models/internal/testutils"
+	zlog "github.com/scanoss/zap-logging-helper/pkg/logger"
+)
+
+func TestIntrospect(t *testing.T) {
+	err := zlog.NewSugaredDevLogger()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a sugared logger", err)
+	}
+	defer zlog.SyncZap()
+	ctx := ctxzap.ToContext(context.Background(), zlog.L)
+	db := testutils.SqliteSetup(t)
+	defer testutils.CloseDB(t, db)
+	testutils.LoadMockSQLData(t, db, "../../internal/testutils/mock")
+
+	info, err := Introspect(ctx, db)
+	if err != nil {
+		t.Fatalf("Introspect() error = %v", err)
+	}
+	fmt.Printf("Schema: %v\n", info)
+	for _, table := range []string{"all_urls", "projects", "licenses", "versions", "mines"} {
+		if !info.HasTable(table) {
+			t.Errorf("SchemaInfo.HasTable() expected table %v", table)
+		}
+	}
+	if info.HasTable("db_version") {
+		t.Errorf("SchemaInfo.HasTable() unexpected table db_version")
+	}
+	if !info.HasColumn("licenses", "is_sanitized") || !info.HasColumn("projects", "verified") {
+		t.Errorf("SchemaInfo.HasColumn() expected optional columns to be detected")
+	}
+	if info.HasColumn("licenses", "no_such_column") || info.HasColumn("no_such_table", "id") {
+		t.Errorf("SchemaInfo.HasColumn() detected a column that does not exist")
+	}
+}
+
+// TestIntrospectOlderSchema tests that models adapt their queries to databases missing optional columns.
+func TestIntrospectOlderSchema(t *testing.T) {
+	err := zlog.NewSugaredDevLogger()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a sugared logger", err)
+	}
+	defer zlog.SyncZap()
+	ctx := ctxzap.ToContext(context.Background(), zlog.L)
+	db := testutils.SqliteSetup(t)
+	defer testutils.CloseDB(t, db)
+	testutils.LoadMockSQLData(t, db, "../../internal/testutils/mock")
+
+	project, err := NewProjectModel(db).GetProjectByPurlName(ctx, "electron-debug", 2)
+	if err != nil {
+		t.Errorf("projects.GetProjectByPurlName() error = %v", err)
+	}
+	if project.Verified != "2022-01-11" {
+		t.Errorf("projects.GetProjectByPurlName() verified = %v, want 2022-01-11", project.Verified)
+	}
+
+	for _, stmt := range []string{"ALTER TABLE licenses DROP COLUMN is_sanitized", "ALTER TABLE projects DROP COLUMN verified"} {
+		if _, err = db.Exec(stmt); err != nil {
+			t.Fatalf("failed to alter mock table: %v", err)
+		}
+	}
+
+	license, err := NewLicenseModel(db).GetLicenseByName(ctx, "MIT")
+	if err != nil {
+		t.Errorf("licenses.GetLicenseByName() error = %v", err)
+	}
+	if license.LicenseName != "MIT" || license.IsSanitized {
+		t.Errorf("licenses.GetLicenseByName() unexpected license: %#v", license)
+	}
+	projects, err := NewProjectModel(db).GetProjectsByPurlName(ctx, "electron-debug", "npm")
+	if err != nil {
+		t.Errorf("projects.GetProjectsByPurlName() error = %v", err)
+	}
+	if len(projects) == 0 || projects[0].Verified != "" {
+		t.Errorf("projects.GetProjectsByPurlName() unexpected projects: %#v", projects)
+	}
+}
@@ -31,7 +31,9 @@ import (
 )
 
 type LicenseModel struct {
-	db *sqlx.DB
+	db     *sqlx.DB
+	schema *schemaCache
+	compat *SchemaStatus // Schema compatibility recorded by NewModels. Nil allows every query
 }
 
 type License struct {
@@ -39,6 +41,7 @@ type License struct {
 	LicenseName string `db:"license_name"`
 	SPDX        string `db:"spdx_id"`
 	IsSpdx      bool   `db:"is_spdx"`
+	IsSanitized bool   `db:"is_sanitized"` // False if the column is not available
 }
 
 var bannedLicPrefixes = []string{"see ", "\"", "'", "-", "*", ".", "/", "?", "@", "\\", ";", ",", "`", "$"} // unwanted license prefixes
@@ -47,44 +50,64 @@ var whiteSpaceRegex = regexp.MustCompile(`\s+`)
 
 // NewLicenseModel create a new instance of the License Model.
 func NewLicenseModel(db *sqlx.DB) *LicenseModel {
-	return &LicenseModel{db: db}
+	return &LicenseModel{db: db, schema: newSchemaCache(db)}
+}
+
+// licenseColumns returns the license columns to select, adapting to the columns available in the database.
+func (m *LicenseModel) licenseColumns(ctx context.Context) string {
+	return "id, COALESCE(license_name, '') AS license_name, COALESCE(spdx_id, '') AS spdx_id, COALESCE(is_spdx, false) AS is_spdx, " +
+		optionalColumn(m.schema.get(ctx), "licenses", "l", "is_sanitized", "false")
 }
 
 // GetLicenseByID retrieves license data by the given row ID.
+// Returns ErrNotFound if there is no license with that ID.
 func (m *LicenseModel) GetLicenseByID(ctx context.Context, id int32) (License, error) {
+	if err := checkSchema(m.compat, ModelLicenses); err != nil {
+		return License{}, err
+	}
 	s := ctxzap.Extract(ctx).Sugar()
 	if id < 0 {
 		s.Error("Please specify a valid License ID to query")
-		return License{}, errors.New("please specify a valid License Name to query")
+		return License{}, invalidInput("please specify a valid License ID to query")
 	}
 	var license License
 	err := m.db.QueryRowxContext(ctx,
-		"SELECT id, license_name, spdx_id, is_spdx FROM licenses"+
+		"SELECT "+m.licenseColumns(ctx)+" FROM licenses l"+
 			" WHERE id = $1",
 		id).StructScan(&license)
-	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+	if errors.Is(err, sql.ErrNoRows) {
+		return License{}, fmt.Errorf("%w: no license with id %v", ErrNotFound, id)
+	}
+	if err != nil {
 		s.Errorf("Error: Failed to query license table for %v: %#v", id, err)
-		return License{}, fmt.Errorf("failed to query the license table: %v", err)
+		return License{}, dbError("failed to query the license table", err)
 	}
 	return license, nil
 }
 
 // GetLicenseByName retrieves the license details for the given license name.
+// Returns ErrNotFound if there is no license with that name.
 func (m *LicenseModel) GetLicenseByName(ctx context.Context, name string) (License, error) {
+	if err := checkSchema(m.compat, ModelLicenses); err != nil {
+		return License{}, err
+	}
 	s := ctxzap.Extract(ctx).Sugar()
 	if len(name) == 0 {
 		s.Warn("No License Name specified to query")
-		return License{}, nil
+		return License{}, invalidInput("please specify a valid License Name to query")
 	}
 	var license License
 	err := m.db.QueryRowxContext(ctx,
-		"SELECT id, license_name, spdx_id, is_spdx FROM licenses"+
+		"SELECT "+m.licenseColumns(ctx)+" FROM licenses l"+
 			" WHERE license_name = $1",
 		name,
 	).StructScan(&license)
-	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+	if errors.Is(err, sql.ErrNoRows) {
+		return License{}, fmt.Errorf("%w: no license named %q", ErrNotFound, name)
+	}
+	if err != nil {
 		s.Errorf("Failed to query license table for %v: %v", name, err)
-		return License{}, fmt.Errorf("failed to query the license table: %v", err)
+		return License{}, dbError("failed to query the license table", err)
 	}
 
 	return license, nil
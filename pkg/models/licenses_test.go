@@ -18,6 +18,7 @@ package models
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"reflect"
 	"testing"
@@ -53,23 +54,16 @@ func TestLicensesSearch(t *testing.T) {
 
 	name = ""
 	fmt.Printf("Searching for license: %v\n", name)
-	license, err = licenseModel.GetLicenseByName(ctx, name)
-	if err != nil {
-		t.Errorf("licenses.GetLicenseByName() error = %v", err)
-	}
-	if len(license.LicenseName) > 0 {
-		t.Errorf("licenses.GetLicenseByName() License returned when one shouldn't")
+	_, err = licenseModel.GetLicenseByName(ctx, name)
+	if !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("licenses.GetLicenseByName() error = %v, want %v", err, ErrInvalidInput)
 	}
-	fmt.Printf("License: %#v\n", license)
 
 	name = "Unknown License"
 	fmt.Printf("Searching for license: %v\n", name)
-	license, err = licenseModel.GetLicenseByName(ctx, name)
-	if err != nil {
-		t.Errorf("licenses.GetLicenseByName() error = %v", err)
-	}
-	if len(license.LicenseName) != 0 {
-		t.Errorf("licenses.GetLicenseByName() License returned when one shouldn't")
+	_, err = licenseModel.GetLicenseByName(ctx, name)
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("licenses.GetLicenseByName() error = %v, want %v", err, ErrNotFound)
 	}
 }
 
@@ -120,6 +114,23 @@ func TestLicensesSearchId(t *testing.T) {
 	}
 	fmt.Printf("License: %#v\n", license)
 
+	id = 9999 // a real row with empty fields
+	fmt.Printf("Searching for license by id: %v\n", id)
+	license, err = licenseModel.GetLicenseByID(ctx, id)
+	if err != nil {
+		t.Errorf("licenses.GetLicenseByID() error = %v", err)
+	}
+	if license.ID != id || len(license.LicenseName) != 0 {
+		t.Errorf("licenses.GetLicenseByID() unexpected license: %#v", license)
+	}
+
+	id = 1
+	fmt.Printf("Searching for license by id: %v\n", id)
+	_, err = licenseModel.GetLicenseByID(ctx, id)
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("licenses.GetLicenseByID() error = %v, want %v", err, ErrNotFound)
+	}
+
 	id = -1
 	fmt.Printf("Searching for license by id: %v\n", id)
 	_, err = licenseModel.GetLicenseByID(ctx, id)
@@ -20,7 +20,6 @@ package models
 
 import (
 	"context"
-	"errors"
 	"fmt"
 
 	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
@@ -28,7 +27,8 @@ import (
 )
 
 type MineModel struct {
-	db *sqlx.DB
+	db     *sqlx.DB
+	compat *SchemaStatus // Schema compatibility recorded by NewModels. Nil allows every query
 }
 
 type Mine struct {
@@ -44,26 +44,38 @@ func NewMineModel(db *sqlx.DB) *MineModel {
 
 // GetMineIdsByPurlType retrieves a list of the Purl Type IDs associated with the given Purl Type (string).
 func (m *MineModel) GetMineIdsByPurlType(ctx context.Context, purlType string) ([]int32, error) {
+	mines, err := m.GetMinesByPurlType(ctx, purlType)
+	if err != nil {
+		return nil, err
+	}
+	var mineIds []int32
+	for _, mine := range mines {
+		mineIds = append(mineIds, mine.ID)
+	}
+	return mineIds, nil
+}
+
+// GetMinesByPurlType retrieves the mines associated with the given Purl Type, ordered by ID.
+func (m *MineModel) GetMinesByPurlType(ctx context.Context, purlType string) ([]Mine, error) {
+	if err := checkSchema(m.compat, ModelMines); err != nil {
+		return nil, err
+	}
 	s := ctxzap.Extract(ctx).Sugar()
 	if len(purlType) == 0 {
 		s.Error("Please specify a Purl Type to query")
-		return nil, errors.New("please specify a Purl Type to query")
+		return nil, invalidInput("please specify a Purl Type to query")
 	}
 	var mines []Mine
 	err := m.db.SelectContext(ctx, &mines,
-		"SELECT id,mine_name,purl_type FROM mines WHERE purl_type = $1", purlType,
+		"SELECT id, COALESCE(mine_name, '') AS mine_name, purl_type FROM mines WHERE purl_type = $1 ORDER BY id", purlType,
 	)
 	if err != nil {
 		s.Errorf("Error: Failed to query mines table for %v: %v", purlType, err)
-		return nil, fmt.Errorf("failed to query the mines table: %v", err)
+		return nil, dbError("failed to query the mines table", err)
 	}
-	if len(mines) > 0 {
-		var mineIds []int32
-		for _, mine := range mines {
-			mineIds = append(mineIds, mine.ID)
-		}
-		return mineIds, nil
+	if len(mines) == 0 {
+		s.Error("No entries found in the mines table.")
+		return nil, fmt.Errorf("%w: no entry in mines table for %v", ErrNotFound, purlType)
 	}
-	s.Error("No entries found in the mines table.")
-	return nil, errors.New("no entry in mines table")
+	return mines, nil
 }
@@ -18,6 +18,7 @@ package models
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"testing"
 
@@ -68,6 +69,9 @@ func TestMines(t *testing.T) {
 	purlType = nonExistentPurlType
 	mineIds, err = mine.GetMineIdsByPurlType(ctx, purlType)
 	if err != nil {
+		if !errors.Is(err, ErrNotFound) {
+			t.Errorf("mines.GetMineIdsByPurlType() error = %v, want %v", err, ErrNotFound)
+		}
 		fmt.Printf("Mine ID not found: %v\n", err)
 	} else {
 		t.Errorf("mines.GetMineIdByPurlType() found for %v = %v", purlType, mineIds)
@@ -79,6 +83,16 @@ func TestMines(t *testing.T) {
 		t.Errorf("mines.GetMineIdsByPurlType() error = %v", err)
 	}
 	fmt.Printf("Mine IDs for %v: %v\n", purlType, mineIds)
+	mines, err := mine.GetMinesByPurlType(ctx, "rpm")
+	if err != nil {
+		t.Errorf("mines.GetMinesByPurlType() error = %v", err)
+	}
+	if len(mines) != 5 || mines[0].Name != "fedoraproject.org" || mines[4].Name != "rpmfusion.org" {
+		t.Errorf("mines.GetMinesByPurlType() unexpected mines: %v", mines)
+	}
+	if _, err = mine.GetMinesByPurlType(ctx, nonExistentPurlType); !errors.Is(err, ErrNotFound) {
+		t.Errorf("mines.GetMinesByPurlType() error = %v, want %v", err, ErrNotFound)
+	}
 }
 
 // TestMinesBadSql test queries without creating/loading the mines table.
@@ -102,3 +116,24 @@ func TestMinesBadSql(t *testing.T) {
 		t.Errorf("mines.GetMineIdByPurlType() found for %v = %v", purlType, mineIds)
 	}
 }
+
+func TestMinesNullName(t *testing.T) {
+	err := zlog.NewSugaredDevLogger()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a sugared logger", err)
+	}
+	defer zlog.SyncZap()
+	ctx := ctxzap.ToContext(context.Background(), zlog.L)
+	db := testutils.SqliteSetup(t) // Setup SQL Lite Models
+	defer testutils.CloseDB(t, db)
+	testutils.LoadMockSQLData(t, db, "../../internal/testutils/mock")
+	testutils.LoadSQLDataFile(t, db, "../../internal/testutils/mock/dangling_ids.sql")
+
+	mineIds, err := NewMineModel(db).GetMineIdsByPurlType(ctx, "dangling")
+	if err != nil {
+		t.Fatalf("mines.GetMineIdsByPurlType() error = %v", err)
+	}
+	if len(mineIds) != 1 || mineIds[0] != 77 {
+		t.Errorf("mines.GetMineIdsByPurlType() = %v, want [77]", mineIds)
+	}
+}
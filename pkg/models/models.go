@@ -17,6 +17,10 @@
 package models
 
 import (
+	"context"
+	"fmt"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
 	"github.com/jmoiron/sqlx"
 )
 
@@ -29,11 +33,49 @@ type Models struct {
 	Licenses  *LicenseModel
 	Mines     *MineModel
 	DBVersion *DBVersionModel
+	Stats     *StatsModel
+	Schema    SchemaStatus // Schema compatibility of the connected database. Checked by every model before querying
 }
 
 // NewModels creates a new instance of the unified SCANOSS models database wrapper.
 // It initializes all individual models and sets up their dependencies.
+// See NewModelsContext for details of the schema compatibility check.
 func NewModels(db *sqlx.DB) *Models {
+	return NewModelsContext(context.Background(), db)
+}
+
+// NewModelsContext creates a new instance of the unified SCANOSS models database wrapper,
+// checking the database schema version with the given context (for logging and cancellation).
+// Unsupported models are recorded in Schema, and return an error wrapping ErrSchemaIncompatible when queried.
+// If the check itself fails, the error is recorded in Schema and every model is reported as unsupported.
+func NewModelsContext(ctx context.Context, db *sqlx.DB) *Models {
+	models := newModels(db)
+	status, err := models.DBVersion.CheckCompatibility(ctx)
+	if err != nil {
+		ctxzap.Extract(ctx).Sugar().Errorf("Failed to check database schema compatibility: %v", err)
+		status = SchemaStatus{checkErr: fmt.Errorf("failed to check database schema compatibility: %w", err)}
+	}
+	models.Schema = status
+	return models
+}
+
+// NewModelsStrict creates a new instance of the unified SCANOSS models database wrapper,
+// failing fast if the database schema version is not supported by every model.
+func NewModelsStrict(ctx context.Context, db *sqlx.DB) (*Models, error) {
+	models := newModels(db)
+	status, err := models.DBVersion.CheckCompatibility(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check database schema compatibility: %w", err)
+	}
+	if err = status.Err(); err != nil {
+		return nil, err
+	}
+	models.Schema = status
+	return models, nil
+}
+
+// newModels initializes all individual models without checking schema compatibility.
+func newModels(db *sqlx.DB) *Models {
 	models := &Models{
 		AllUrls:   NewAllURLModel(db),
 		Projects:  NewProjectModel(db),
@@ -41,7 +83,12 @@ func NewModels(db *sqlx.DB) *Models {
 		Licenses:  NewLicenseModel(db),
 		Mines:     NewMineModel(db),
 		DBVersion: NewDBVersionModel(db),
+		Stats:     NewStatsModel(db),
 	}
-
+	models.AllUrls.compat = &models.Schema
+	models.Projects.compat = &models.Schema
+	models.Versions.compat = &models.Schema
+	models.Licenses.compat = &models.Schema
+	models.Mines.compat = &models.Schema
 	return models
 }
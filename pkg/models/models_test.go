@@ -59,4 +59,8 @@ func TestNewDB(t *testing.T) {
 	if models.DBVersion == nil {
 		t.Error("NewModels did not initialize DBVersion model")
 	}
+
+	if models.Stats == nil {
+		t.Error("NewModels did not initialize Stats model")
+	}
 }
@@ -20,7 +20,6 @@ package models
 
 import (
 	"context"
-	"errors"
 	"fmt"
 
 	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
@@ -28,69 +27,116 @@ import (
 )
 
 type ProjectModel struct {
-	db *sqlx.DB
+	db     *sqlx.DB
+	schema *schemaCache
+	compat *SchemaStatus // Schema compatibility recorded by NewModels. Nil allows every query
 }
 
+// Joined license fields are empty if the referenced row does not exist (see HasLicense and HasGitLicense).
 type Project struct {
-	PurlName     string `db:"purl_name"`
-	Component    string `db:"component"`
-	License      string `db:"license"`
-	LicenseID    string `db:"license_id"`
-	IsSpdx       bool   `db:"is_spdx"`
-	GitLicense   string `db:"g_license"`
-	GitLicenseID string `db:"g_license_id"`
-	GitIsSpdx    bool   `db:"g_is_spdx"`
+	PurlName      string `db:"purl_name"`
+	Component     string `db:"component"`
+	License       string `db:"license"`
+	LicenseID     string `db:"license_id"`
+	IsSpdx        bool   `db:"is_spdx"`
+	HasLicense    bool   `db:"has_license"` // True if the license_id references a licenses row
+	GitLicense    string `db:"g_license"`
+	GitLicenseID  string `db:"g_license_id"`
+	GitIsSpdx     bool   `db:"g_is_spdx"`
+	HasGitLicense bool   `db:"has_g_license"` // True if the git_license_id references a licenses row
+	Verified      string `db:"verified"`      // Empty if not verified or the column is not available
+}
+
+// projectColumns selects the Project columns from projects p, joined with licenses l and g (git license).
+// Nullable columns are coalesced to their zero value.
+const projectColumns = "p.purl_name, p.component," +
+	" COALESCE(l.license_name, '') AS license, COALESCE(l.spdx_id, '') AS license_id, COALESCE(l.is_spdx, false) AS is_spdx," +
+	" l.id IS NOT NULL AS has_license," +
+	" COALESCE(g.license_name, '') AS g_license, COALESCE(g.spdx_id, '') AS g_license_id, COALESCE(g.is_spdx, false) AS g_is_spdx," +
+	" g.id IS NOT NULL AS has_g_license, "
+
+// ProjectPurl identifies a project by its Purl Type and Name.
+type ProjectPurl struct {
+	PurlType string `db:"purl_type"`
+	PurlName string `db:"purl_name"`
 }
 
 // NewProjectModel creates a new instance of the Project Model.
 func NewProjectModel(db *sqlx.DB) *ProjectModel {
-	return &ProjectModel{db: db}
+	return &ProjectModel{db: db, schema: newSchemaCache(db)}
+}
+
+// optionalColumns returns the optional project columns to select, adapting to the columns available in the database.
+func (m *ProjectModel) optionalColumns(ctx context.Context) string {
+	return optionalColumn(m.schema.get(ctx), "projects", "p", "verified", "''")
 }
 
 // GetProjectsByPurlName searches the projects' table for details about Purl Name and Type.
 func (m *ProjectModel) GetProjectsByPurlName(ctx context.Context, purlName string, purlType string) ([]Project, error) {
+	return m.GetProjectsByPurlNameWithOptions(ctx, purlName, purlType, URLQueryOptions{})
+}
+
+// GetProjectsByPurlNameWithOptions searches the projects' table for details about Purl Name and Type,
+// only returning the projects with at least one all_urls row (artifact) matching the given options.
+// The options are ignored if they apply no filters, so projects without any artifacts are returned too.
+func (m *ProjectModel) GetProjectsByPurlNameWithOptions(ctx context.Context, purlName, purlType string, opts URLQueryOptions) ([]Project, error) {
+	if err := checkSchema(m.compat, ModelProjects); err != nil {
+		return nil, err
+	}
 	s := ctxzap.Extract(ctx).Sugar()
 	if len(purlName) == 0 {
 		s.Error("Please specify a valid Purl Name to query")
-		return nil, errors.New("please specify a valid Purl Name to query")
+		return nil, invalidInput("please specify a valid Purl Name to query")
 	}
 	if len(purlType) == 0 {
 		s.Error("Please specify a valid Purl Type to query")
-		return nil, errors.New("please specify a valid Purl Type to query")
+		return nil, invalidInput("please specify a valid Purl Type to query")
+	}
+	if err := opts.validate(); err != nil {
+		s.Errorf("Invalid query options for %v - %v: %v", purlType, purlName, err)
+		return nil, err
+	}
+	query := "SELECT " + projectColumns + m.optionalColumns(ctx) +
+		" FROM projects p" +
+		" LEFT JOIN mines m ON p.mine_id = m.id" +
+		" LEFT JOIN licenses l ON p.license_id = l.id" +
+		" LEFT JOIN licenses g ON p.git_license_id = g.id" +
+		" WHERE m.purl_type = ? AND p.purl_name = ?"
+	args := []any{purlType, purlName}
+	if filter, filterArgs := opts.where(); len(filter) > 0 {
+		// The subquery aliases (u, m and l) are those expected by URLQueryOptions, shadowing the outer ones
+		query += " AND EXISTS (SELECT 1 FROM all_urls u" +
+			" LEFT JOIN mines m ON u.mine_id = m.id" +
+			" LEFT JOIN licenses l ON u.license_id = l.id" +
+			" WHERE u.mine_id = p.mine_id AND u.purl_name = p.purl_name" + filter + ")"
+		args = append(args, filterArgs...)
 	}
 	var allProjects []Project
-	err := m.db.SelectContext(ctx, &allProjects,
-		"SELECT purl_name, component,"+
-			" l.license_name AS   license, l.spdx_id AS   license_id, l.is_spdx AS   is_spdx,"+
-			" g.license_name AS g_license, g.spdx_id AS g_license_id, g.is_spdx AS g_is_spdx"+
-			" FROM projects p"+
-			" LEFT JOIN mines m ON p.mine_id = m.id"+
-			" LEFT JOIN licenses l ON p.license_id = l.id"+
-			" LEFT JOIN licenses g ON p.git_license_id = g.id"+
-			" WHERE m.purl_type = $1 AND p.purl_name = $2",
-		purlType, purlName)
+	err := m.db.SelectContext(ctx, &allProjects, m.db.Rebind(query), args...)
 	if err != nil {
 		s.Errorf("Failed to query projects table for %v, %v: %v", purlName, purlType, err)
-		return nil, fmt.Errorf("failed to query the projects table: %v", err)
+		return nil, dbError("failed to query the projects table", err)
 	}
 	return allProjects, nil
 }
 
 // GetProjectByPurlName searches the projects' table for details about a Purl Name and Mine ID.
+// Returns ErrNotFound if there is no matching project.
 func (m *ProjectModel) GetProjectByPurlName(ctx context.Context, purlName string, mineID int32) (Project, error) {
+	if err := checkSchema(m.compat, ModelProjects); err != nil {
+		return Project{}, err
+	}
 	s := ctxzap.Extract(ctx).Sugar()
 	if len(purlName) == 0 {
 		s.Error("Please specify a valid Purl Name to query")
-		return Project{}, errors.New("please specify a valid Purl Name to query")
+		return Project{}, invalidInput("please specify a valid Purl Name to query")
 	}
 	if mineID < 0 {
 		s.Error("Please specify a valid Mine ID to query")
-		return Project{}, errors.New("please specify a valid Mine ID to query")
+		return Project{}, invalidInput("please specify a valid Mine ID to query")
 	}
 	rows, err := m.db.QueryxContext(ctx,
-		"SELECT purl_name, component,"+
-			" l.license_name AS   license, l.spdx_id AS   license_id, l.is_spdx AS   is_spdx,"+
-			" g.license_name AS g_license, g.spdx_id AS g_license_id, g.is_spdx AS g_is_spdx"+
+		"SELECT "+projectColumns+m.optionalColumns(ctx)+
 			" FROM projects p"+
 			" LEFT JOIN licenses l ON p.license_id = l.id"+
 			" LEFT JOIN licenses g ON p.git_license_id = g.id"+
@@ -108,30 +154,37 @@ func (m *ProjectModel) GetProjectByPurlName(ctx context.Context, purlName string
 
 	if err != nil {
 		s.Errorf("Error: Failed to query projects table for %v, %v: %v", purlName, mineID, err)
-		return Project{}, fmt.Errorf("failed to query the projects table: %v", err)
+		return Project{}, dbError("failed to query the projects table", err)
 	}
-	var project Project
-	if rows.Next() {
-		err = rows.StructScan(&project)
-		if err != nil {
-			s.Errorf("Failed to parse projects table results for %#v: %v", rows, err)
-			s.Errorf("Query failed for purl_name = %v, mine_id = %v", purlName, mineID)
-			return Project{}, fmt.Errorf("failed to query the projects table: %v", err)
+	if !rows.Next() {
+		if err = rows.Err(); err != nil {
+			return Project{}, dbError("failed to query the projects table", err)
 		}
+		return Project{}, fmt.Errorf("%w: no project %v in mine %v", ErrNotFound, purlName, mineID)
+	}
+	var project Project
+	err = rows.StructScan(&project)
+	if err != nil {
+		s.Errorf("Failed to parse projects table results for %#v: %v", rows, err)
+		s.Errorf("Query failed for purl_name = %v, mine_id = %v", purlName, mineID)
+		return Project{}, dbError("failed to query the projects table", err)
 	}
 	return project, nil
 }
 
 // CheckPurlByNameType checks the projects table for the count of entries matching a Purl Name and Type.
 func (m *ProjectModel) CheckPurlByNameType(ctx context.Context, purlName string, purlType string) (int, error) {
+	if err := checkSchema(m.compat, ModelProjects); err != nil {
+		return -1, err
+	}
 	s := ctxzap.Extract(ctx).Sugar()
 	if len(purlName) == 0 {
 		s.Error("Please specify a valid Purl Name to query")
-		return -1, errors.New("please specify a valid Purl Name to query")
+		return -1, invalidInput("please specify a valid Purl Name to query")
 	}
 	if len(purlType) == 0 {
 		s.Error("Please specify a valid Purl Type to query")
-		return -1, errors.New("please specify a valid Purl Type to query")
+		return -1, invalidInput("please specify a valid Purl Type to query")
 	}
 	var count int
 	err := m.db.QueryRowxContext(ctx,
@@ -142,7 +195,90 @@ func (m *ProjectModel) CheckPurlByNameType(ctx context.Context, purlName string,
 		purlName, purlType).Scan(&count)
 	if err != nil {
 		s.Errorf("Error: Failed to query projects table for %v, %v: %v", purlName, purlType, err)
-		return -1, fmt.Errorf("failed to query the projects table: %v", err)
+		return -1, dbError("failed to query the projects table", err)
+	}
+	return count, nil
+}
+
+// CheckPurlByNameTypeMines returns the number of projects matching the given Purl Name and Type in any of the given mines.
+func (m *ProjectModel) CheckPurlByNameTypeMines(ctx context.Context, purlName, purlType string, mineIDs []int32) (int, error) {
+	if err := checkSchema(m.compat, ModelProjects); err != nil {
+		return -1, err
+	}
+	s := ctxzap.Extract(ctx).Sugar()
+	if len(purlName) == 0 {
+		s.Error("Please specify a valid Purl Name to query")
+		return -1, invalidInput("please specify a valid Purl Name to query")
+	}
+	if len(purlType) == 0 {
+		s.Error("Please specify a valid Purl Type to query")
+		return -1, invalidInput("please specify a valid Purl Type to query")
+	}
+	if len(mineIDs) == 0 {
+		s.Error("Please specify the mines to query")
+		return -1, invalidInput("please specify the mines to query")
+	}
+	query, args, err := sqlx.In("SELECT count(*)"+
+		" FROM projects p"+
+		" INNER JOIN mines m ON p.mine_id = m.id"+
+		" WHERE p.purl_name = ? AND m.purl_type = ? AND p.mine_id IN (?)",
+		purlName, purlType, mineIDs)
+	if err != nil {
+		return -1, dbError("failed to build the projects query", err)
+	}
+	var count int
+	if err = m.db.QueryRowxContext(ctx, m.db.Rebind(query), args...).Scan(&count); err != nil {
+		s.Errorf("Error: Failed to query projects table for %v, %v in mines %v: %v", purlName, purlType, mineIDs, err)
+		return -1, dbError("failed to query the projects table", err)
 	}
 	return count, nil
 }
+
+// GetAllProjectPurls retrieves the distinct Purl Type and Name of every project in the projects table.
+func (m *ProjectModel) GetAllProjectPurls(ctx context.Context) ([]ProjectPurl, error) {
+	if err := checkSchema(m.compat, ModelProjects); err != nil {
+		return nil, err
+	}
+	s := ctxzap.Extract(ctx).Sugar()
+	var purls []ProjectPurl
+	err := m.db.SelectContext(ctx, &purls,
+		"SELECT DISTINCT COALESCE(m.purl_type, '') AS purl_type, p.purl_name"+
+			" FROM projects p"+
+			" INNER JOIN mines m ON p.mine_id = m.id"+
+			" ORDER BY m.purl_type, p.purl_name")
+	if err != nil {
+		s.Errorf("Error: Failed to query projects table for all purls: %v", err)
+		return nil, dbError("failed to query the projects table", err)
+	}
+	return purls, nil
+}
+
+// ProjectLicense is a license (or git license) declared by a project.
+type ProjectLicense struct {
+	PurlType string `db:"purl_type"`
+	PurlName string `db:"purl_name"`
+	License  string `db:"license"`
+}
+
+// GetAllProjectLicenses retrieves the distinct licenses and git licenses of every project in the projects table,
+// ordered by Purl Type, Name and license. Licenses referencing a missing licenses row are ignored.
+func (m *ProjectModel) GetAllProjectLicenses(ctx context.Context) ([]ProjectLicense, error) {
+	if err := checkSchema(m.compat, ModelProjects); err != nil {
+		return nil, err
+	}
+	var licenses []ProjectLicense
+	err := m.db.SelectContext(ctx, &licenses,
+		"SELECT m.purl_type, p.purl_name, l.license_name AS license FROM projects p"+
+			" INNER JOIN mines m ON p.mine_id = m.id"+
+			" INNER JOIN licenses l ON p.license_id = l.id WHERE l.license_name != ''"+
+			" UNION"+
+			" SELECT m.purl_type, p.purl_name, g.license_name AS license FROM projects p"+
+			" INNER JOIN mines m ON p.mine_id = m.id"+
+			" INNER JOIN licenses g ON p.git_license_id = g.id WHERE g.license_name != ''"+
+			" ORDER BY purl_type, purl_name, license")
+	if err != nil {
+		ctxzap.Extract(ctx).Sugar().Errorf("Error: Failed to query projects table for all licenses: %v", err)
+		return nil, dbError("failed to query the projects table", err)
+	}
+	return licenses, nil
+}
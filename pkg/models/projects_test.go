@@ -18,6 +18,7 @@ package models
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"testing"
 
@@ -80,6 +81,10 @@ func TestProjectsSearch(t *testing.T) {
 	} else {
 		fmt.Printf("Project: %v\n", project)
 	}
+	_, err = projectsModel.GetProjectByPurlName(ctx, "tablestyle", 2)
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("projects.GetProjectByPurlName() error = %v, want %v", err, ErrNotFound)
+	}
 	purlName = ""
 	mineId = -1
 	fmt.Printf("Searching for project list: %v - %v\n", purlName, purlType)
@@ -125,3 +130,146 @@ func TestProjectsSearchBadSql(t *testing.T) {
 		fmt.Printf("Got expected error = %v\n", err)
 	}
 }
+
+func TestGetAllProjectPurls(t *testing.T) {
+	err := zlog.NewSugaredDevLogger()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a sugared logger", err)
+	}
+	defer zlog.SyncZap()
+	ctx := ctxzap.ToContext(context.Background(), zlog.L)
+	db := testutils.SqliteSetup(t) // Setup SQL Lite DB
+	defer testutils.CloseDB(t, db)
+	testutils.LoadMockSQLData(t, db, "../../internal/testutils/mock")
+
+	projectsModel := NewProjectModel(db)
+	purls, err := projectsModel.GetAllProjectPurls(ctx)
+	if err != nil {
+		t.Errorf("projects.GetAllProjectPurls() error = %v", err)
+	}
+	found := false
+	for _, purl := range purls {
+		if purl.PurlType == "gem" && purl.PurlName == "tablestyle" {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("projects.GetAllProjectPurls() did not return gem/tablestyle: %v", purls)
+	}
+	fmt.Printf("Project purls: %v\n", len(purls))
+
+	licenses, err := projectsModel.GetAllProjectLicenses(ctx)
+	if err != nil {
+		t.Errorf("projects.GetAllProjectLicenses() error = %v", err)
+	}
+	var tablestyle []string
+	for _, license := range licenses {
+		if license.PurlType == "gem" && license.PurlName == "tablestyle" {
+			tablestyle = append(tablestyle, license.License)
+		}
+	}
+	if len(tablestyle) != 1 || tablestyle[0] != "MIT" {
+		t.Errorf("projects.GetAllProjectLicenses() unexpected gem/tablestyle licenses: %v", tablestyle)
+	}
+}
+
+func TestProjectsDanglingIDs(t *testing.T) {
+	err := zlog.NewSugaredDevLogger()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a sugared logger", err)
+	}
+	defer zlog.SyncZap()
+	ctx := ctxzap.ToContext(context.Background(), zlog.L)
+	db := testutils.SqliteSetup(t) // Setup SQL Lite DB
+	defer testutils.CloseDB(t, db)
+	testutils.LoadMockSQLData(t, db, "../../internal/testutils/mock")
+	testutils.LoadSQLDataFile(t, db, "../../internal/testutils/mock/dangling_ids.sql")
+
+	projectsModel := NewProjectModel(db)
+	project, err := projectsModel.GetProjectByPurlName(ctx, "dangling-ids", 2)
+	if err != nil {
+		t.Fatalf("projects.GetProjectByPurlName() error = %v", err)
+	}
+	if project.HasLicense || project.HasGitLicense || len(project.License) > 0 || len(project.GitLicense) > 0 {
+		t.Errorf("projects.GetProjectByPurlName() unexpected licenses for dangling ids: %#v", project)
+	}
+	projects, err := projectsModel.GetProjectsByPurlName(ctx, "tablestyle", "gem")
+	if err != nil {
+		t.Fatalf("projects.GetProjectsByPurlName() error = %v", err)
+	}
+	if len(projects) != 1 || !projects[0].HasLicense || !projects[0].HasGitLicense || projects[0].License != "MIT" {
+		t.Errorf("projects.GetProjectsByPurlName() unexpected projects: %#v", projects)
+	}
+}
+
+func TestCheckPurlByNameTypeMines(t *testing.T) {
+	err := zlog.NewSugaredDevLogger()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a sugared logger", err)
+	}
+	defer zlog.SyncZap()
+	ctx := ctxzap.ToContext(context.Background(), zlog.L)
+	db := testutils.SqliteSetup(t) // Setup SQL Lite DB
+	defer testutils.CloseDB(t, db)
+	testutils.LoadMockSQLData(t, db, "../../internal/testutils/mock")
+	testutils.LoadSQLDataFile(t, db, "../../internal/testutils/mock/multi_mine.sql")
+
+	projectsModel := NewProjectModel(db)
+	tests := []struct {
+		name    string
+		mineIDs []int32
+		want    int
+		wantErr error
+	}{
+		{name: "single mine", mineIDs: []int32{7}, want: 1},
+		{name: "several mines", mineIDs: []int32{7, 8, 19}, want: 2},
+		{name: "other mine", mineIDs: []int32{8}, want: 0},
+		{name: "mine of another type", mineIDs: []int32{2}, want: 0},
+		{name: "no mines", mineIDs: nil, want: -1, wantErr: ErrInvalidInput},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			count, checkErr := projectsModel.CheckPurlByNameTypeMines(ctx, "curl", "rpm", tt.mineIDs)
+			if !errors.Is(checkErr, tt.wantErr) || count != tt.want {
+				t.Errorf("projects.CheckPurlByNameTypeMines() = %v, %v, want %v, %v", count, checkErr, tt.want, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGetProjectsByPurlNameWithOptions(t *testing.T) {
+	err := zlog.NewSugaredDevLogger()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a sugared logger", err)
+	}
+	defer zlog.SyncZap()
+	ctx := ctxzap.ToContext(context.Background(), zlog.L)
+	db := testutils.SqliteSetup(t) // Setup SQL Lite DB
+	defer testutils.CloseDB(t, db)
+	testutils.LoadMockSQLData(t, db, "../../internal/testutils/mock")
+	testutils.LoadSQLDataFile(t, db, "../../internal/testutils/mock/multi_mine.sql")
+
+	projectsModel := NewProjectModel(db)
+	tests := []struct {
+		name    string
+		opts    URLQueryOptions
+		want    int
+		wantErr error
+	}{
+		{name: "no filters", want: 2},
+		{name: "mine", opts: URLQueryOptions{MineIDs: []int32{7}}, want: 1},
+		{name: "mine without project", opts: URLQueryOptions{MineIDs: []int32{8}}, want: 0},
+		{name: "file type", opts: URLQueryOptions{FileType: "rpm"}, want: 2},
+		{name: "file type without artifacts", opts: URLQueryOptions{FileType: "jar"}, want: 0},
+		{name: "mine and date", opts: URLQueryOptions{MineIDs: []int32{7, 19}, DateFrom: "2021-06-01"}, want: 1},
+		{name: "invalid options", opts: URLQueryOptions{MineIDs: []int32{-1}}, wantErr: ErrInvalidInput},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			projects, getErr := projectsModel.GetProjectsByPurlNameWithOptions(ctx, "curl", "rpm", tt.opts)
+			if !errors.Is(getErr, tt.wantErr) || len(projects) != tt.want {
+				t.Errorf("projects.GetProjectsByPurlNameWithOptions() = %v, %v, want %v, %v", len(projects), getErr, tt.want, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,165 @@
+// SPDX-License-Identifier: GPL-2.0-or-later
+/*
+ * Copyright (C) 2026 SCANOSS.COM
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 2 of the License, or
+ * (at your option) any later version.
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+// Handle schema version compatibility checks against the db_version table
+
+package models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
+)
+
+// Model names used when declaring and reporting schema compatibility.
+const (
+	ModelAllUrls  = "all_urls"
+	ModelProjects = "projects"
+	ModelVersions = "versions"
+	ModelLicenses = "licenses"
+	ModelMines    = "mines"
+)
+
+// ErrSchemaIncompatible is returned when the database schema version is not supported by a model.
+var ErrSchemaIncompatible = errors.New("incompatible database schema version")
+
+// supportedSchemas declares the db_version schema versions supported by each model.
+var supportedSchemas = map[string]string{
+	ModelAllUrls:  ">= 1.0.0, < 2.0.0",
+	ModelProjects: ">= 1.0.0, < 2.0.0",
+	ModelVersions: ">= 1.0.0, < 2.0.0",
+	ModelLicenses: ">= 1.0.0, < 2.0.0",
+	ModelMines:    ">= 1.0.0, < 2.0.0",
+}
+
+// SchemaStatus reports the compatibility of the connected database with each model.
+type SchemaStatus struct {
+	Versions    map[string]string // Schema version reported by each db_version package. Empty if unknown
+	Unsupported map[string]error  // Models that do not support the schema version, keyed by model name
+	checkErr    error             // Error from a failed compatibility check. Every model is treated as unsupported
+}
+
+// Compatible returns true if every model supports the database schema version.
+func (s SchemaStatus) Compatible() bool {
+	return s.checkErr == nil && len(s.Unsupported) == 0
+}
+
+// Check returns an error wrapping ErrSchemaIncompatible if the given model does not support the schema version,
+// or if the compatibility check itself failed.
+func (s SchemaStatus) Check(model string) error {
+	if s.checkErr != nil {
+		return fmt.Errorf("%w: %v model: %w", ErrSchemaIncompatible, model, s.checkErr)
+	}
+	if err, ok := s.Unsupported[model]; ok {
+		return err
+	}
+	return nil
+}
+
+// Err returns the combined incompatibility errors for all unsupported models, or nil if compatible.
+func (s SchemaStatus) Err() error {
+	if s.Compatible() {
+		return nil
+	}
+	if s.checkErr != nil {
+		return fmt.Errorf("%w: %w", ErrSchemaIncompatible, s.checkErr)
+	}
+	names := make([]string, 0, len(s.Unsupported))
+	for name := range s.Unsupported {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	errs := make([]error, 0, len(names))
+	for _, name := range names {
+		errs = append(errs, s.Unsupported[name])
+	}
+	return errors.Join(errs...)
+}
+
+// CheckCompatibility validates the schema version of every db_version row against the versions supported by each model.
+// Databases that predate the db_version table, or have no version recorded, are assumed to be compatible.
+func (m *DBVersionModel) CheckCompatibility(ctx context.Context) (SchemaStatus, error) {
+	s := ctxzap.Extract(ctx).Sugar()
+	rows, err := m.getRows(ctx)
+	if err != nil {
+		if errors.Is(err, ErrTableNotFound) {
+			s.Debug("No db_version table. Assuming a compatible schema")
+			return SchemaStatus{}, nil
+		}
+		return SchemaStatus{}, err
+	}
+	if len(rows) == 0 {
+		s.Debug("No version recorded in db_version. Assuming a compatible schema")
+		return SchemaStatus{}, nil
+	}
+	constraints := make(map[string]*semver.Constraints, len(supportedSchemas))
+	for name, supported := range supportedSchemas {
+		c, cErr := semver.NewConstraint(supported)
+		if cErr != nil {
+			return SchemaStatus{}, fmt.Errorf("invalid schema constraint for %v model: %w", name, cErr)
+		}
+		constraints[name] = c
+	}
+	var status SchemaStatus
+	for _, row := range rows {
+		if len(row.SchemaVersion) == 0 {
+			s.Debugf("No schema version recorded for package %v. Assuming a compatible schema", row.PackageName)
+			continue
+		}
+		if status.Versions == nil {
+			status.Versions = make(map[string]string)
+		}
+		status.Versions[row.PackageName] = row.SchemaVersion
+		version, vErr := semver.NewVersion(row.SchemaVersion)
+		if vErr != nil {
+			s.Warnf("Failed to parse schema version '%v' of package %v: %v", row.SchemaVersion, row.PackageName, vErr)
+		}
+		for name, c := range constraints {
+			if version == nil {
+				status.addUnsupported(name, fmt.Errorf("%w: %v model cannot parse schema version %q of package %v",
+					ErrSchemaIncompatible, name, row.SchemaVersion, row.PackageName))
+			} else if !c.Check(version) {
+				status.addUnsupported(name, fmt.Errorf("%w: %v model supports %q, package %v has %v",
+					ErrSchemaIncompatible, name, supportedSchemas[name], row.PackageName, row.SchemaVersion))
+			}
+		}
+	}
+	if !status.Compatible() {
+		s.Warnf("Database schema versions %v are not supported by: %v", status.Versions, status.Err())
+	}
+	return status, nil
+}
+
+// checkSchema returns an error if the given model does not support the database schema recorded in status.
+// A nil status (i.e. a model created on its own, rather than by NewModels) allows every query.
+func checkSchema(status *SchemaStatus, model string) error {
+	if status == nil {
+		return nil
+	}
+	return status.Check(model)
+}
+
+// addUnsupported records a model as not supporting a schema version, joining the errors of multiple packages.
+func (s *SchemaStatus) addUnsupported(model string, err error) {
+	if s.Unsupported == nil {
+		s.Unsupported = make(map[string]error)
+	}
+	s.Unsupported[model] = errors.Join(s.Unsupported[model], err)
+}
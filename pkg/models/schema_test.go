@@ -0,0 +1,188 @@
+// SPDX-License-Identifier: GPL-2.0-or-later
+/*
+ * Copyright (C) 2026 SCANOSS.COM
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 2 of the License, or
+ * (at your option) any later version.
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
+	"github.com/scanoss/go-models/internal/testutils"
+	zlog "github.com/scanoss/zap-logging-helper/pkg/logger"
+)
+
+func TestCheckCompatibility(t *testing.T) {
+	err := zlog.NewSugaredDevLogger()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a sugared logger", err)
+	}
+	defer zlog.SyncZap()
+	ctx := ctxzap.ToContext(context.Background(), zlog.L)
+	db := testutils.SqliteSetup(t)
+	defer testutils.CloseDB(t, db)
+
+	model := NewDBVersionModel(db)
+
+	fmt.Println("Testing CheckCompatibility without db_version table...")
+	status, err := model.CheckCompatibility(ctx)
+	if err != nil {
+		t.Errorf("DBVersionModel.CheckCompatibility() error = %v", err)
+	}
+	if !status.Compatible() {
+		t.Errorf("DBVersionModel.CheckCompatibility() expected compatible schema for missing table, got %v", status.Err())
+	}
+
+	testutils.LoadSQLDataFile(t, db, "../../internal/testutils/mock/db_version.sql")
+	fmt.Println("Testing CheckCompatibility with supported schema...")
+	status, err = model.CheckCompatibility(ctx)
+	if err != nil {
+		t.Errorf("DBVersionModel.CheckCompatibility() error = %v", err)
+	}
+	if !status.Compatible() || status.Versions["base"] != "1.0.0" || status.Versions["licenses"] != "1.1.0" {
+		t.Errorf("DBVersionModel.CheckCompatibility() expected compatible schemas 1.0.0 and 1.1.0, got %#v", status)
+	}
+
+	fmt.Println("Testing CheckCompatibility with one unsupported package...")
+	if _, err = db.Exec("UPDATE db_version SET schema_version = '2.0.0' WHERE package_name = 'licenses'"); err != nil {
+		t.Fatalf("failed to update db_version table: %v", err)
+	}
+	status, err = model.CheckCompatibility(ctx)
+	if err != nil {
+		t.Errorf("DBVersionModel.CheckCompatibility() error = %v", err)
+	}
+	if status.Compatible() || !errors.Is(status.Check(ModelLicenses), ErrSchemaIncompatible) {
+		t.Errorf("DBVersionModel.CheckCompatibility() expected every row to be checked, got %#v", status)
+	}
+
+	tests := []struct {
+		name    string
+		version string
+	}{
+		{name: "newer major version", version: "2.1.0"},
+		{name: "older major version", version: "0.9.0"},
+		{name: "unparsable version", version: "not-a-version"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, execErr := db.Exec("UPDATE db_version SET schema_version = $1", tt.version); execErr != nil {
+				t.Fatalf("failed to update db_version table: %v", execErr)
+			}
+			got, checkErr := model.CheckCompatibility(ctx)
+			if checkErr != nil {
+				t.Errorf("DBVersionModel.CheckCompatibility() error = %v", checkErr)
+			}
+			if got.Compatible() {
+				t.Errorf("DBVersionModel.CheckCompatibility() expected incompatible schema for %v", tt.version)
+			}
+			if !errors.Is(got.Check(ModelAllUrls), ErrSchemaIncompatible) {
+				t.Errorf("SchemaStatus.Check() expected ErrSchemaIncompatible, got %v", got.Check(ModelAllUrls))
+			}
+			if !errors.Is(got.Err(), ErrSchemaIncompatible) {
+				t.Errorf("SchemaStatus.Err() expected ErrSchemaIncompatible, got %v", got.Err())
+			}
+			fmt.Printf("Got expected error = %v\n", got.Err())
+		})
+	}
+
+	fmt.Println("Testing CheckCompatibility with no version recorded...")
+	if _, err = db.Exec("DELETE FROM db_version"); err != nil {
+		t.Fatalf("failed to clear db_version table: %v", err)
+	}
+	status, err = model.CheckCompatibility(ctx)
+	if err != nil || !status.Compatible() {
+		t.Errorf("DBVersionModel.CheckCompatibility() expected compatible schema for empty table, got %v, %v", status.Err(), err)
+	}
+}
+
+func TestNewModelsStrict(t *testing.T) {
+	err := zlog.NewSugaredDevLogger()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a sugared logger", err)
+	}
+	defer zlog.SyncZap()
+	ctx := ctxzap.ToContext(context.Background(), zlog.L)
+	db := testutils.SqliteSetup(t)
+	defer testutils.CloseDB(t, db)
+	testutils.LoadMockSQLData(t, db, "../../internal/testutils/mock")
+	testutils.LoadSQLDataFile(t, db, "../../internal/testutils/mock/db_version.sql")
+
+	models, err := NewModelsStrict(ctx, db)
+	if err != nil {
+		t.Errorf("NewModelsStrict() error = %v", err)
+	}
+	if models == nil || !models.Schema.Compatible() {
+		t.Errorf("NewModelsStrict() expected compatible models, got %#v", models)
+	}
+
+	if _, err = db.Exec("UPDATE db_version SET schema_version = '2.0.0'"); err != nil {
+		t.Fatalf("failed to update db_version table: %v", err)
+	}
+	_, err = NewModelsStrict(ctx, db)
+	if !errors.Is(err, ErrSchemaIncompatible) {
+		t.Errorf("NewModelsStrict() expected ErrSchemaIncompatible, got %v", err)
+	}
+
+	models = NewModels(db)
+	if models.Schema.Compatible() {
+		t.Errorf("NewModels() expected incompatible schema to be recorded")
+	}
+	if !errors.Is(models.Schema.Check(ModelProjects), ErrSchemaIncompatible) {
+		t.Errorf("NewModels() expected projects model to be disabled, got %v", models.Schema.Check(ModelProjects))
+	}
+	// The models check the schema themselves, so direct callers are covered too
+	if _, err = models.Licenses.GetLicenseByID(ctx, 5614); !errors.Is(err, ErrSchemaIncompatible) {
+		t.Errorf("LicenseModel.GetLicenseByID() expected ErrSchemaIncompatible, got %v", err)
+	}
+	if _, err = models.Mines.GetMinesByPurlType(ctx, "npm"); !errors.Is(err, ErrSchemaIncompatible) {
+		t.Errorf("MineModel.GetMinesByPurlType() expected ErrSchemaIncompatible, got %v", err)
+	}
+	if _, err = models.AllUrls.GetURLsByDownloadURL(ctx, "https://rubygems.org/downloads/tablestyle-0.0.12.gem"); !errors.Is(err, ErrSchemaIncompatible) {
+		t.Errorf("AllUrlsModel.GetURLsByDownloadURL() expected ErrSchemaIncompatible, got %v", err)
+	}
+	// Models created on their own are not gated
+	if _, err = NewLicenseModel(db).GetLicenseByID(ctx, 5614); err != nil {
+		t.Errorf("LicenseModel.GetLicenseByID() unexpected error for a standalone model: %v", err)
+	}
+	models = NewModelsContext(ctx, db)
+	if !errors.Is(models.Schema.Check(ModelVersions), ErrSchemaIncompatible) {
+		t.Errorf("NewModelsContext() expected versions model to be disabled, got %v", models.Schema.Check(ModelVersions))
+	}
+}
+
+func TestNewModelsFailedCheck(t *testing.T) {
+	db := testutils.SqliteSetup(t)
+	defer testutils.CloseDB(t, db)
+	// A db_version table missing its columns makes the version query fail
+	if _, err := db.Exec("CREATE TABLE db_version (package_name text)"); err != nil {
+		t.Fatalf("failed to create db_version table: %v", err)
+	}
+	models := NewModels(db)
+	if models.Schema.Compatible() {
+		t.Errorf("NewModels() expected a failed check to be recorded as incompatible")
+	}
+	for _, name := range []string{ModelAllUrls, ModelProjects, ModelMines} {
+		err := models.Schema.Check(name)
+		if !errors.Is(err, ErrSchemaIncompatible) || !errors.Is(err, ErrDatabase) {
+			t.Errorf("SchemaStatus.Check(%v) expected ErrSchemaIncompatible and ErrDatabase, got %v", name, err)
+		}
+	}
+	if !errors.Is(models.Schema.Err(), ErrDatabase) {
+		t.Errorf("SchemaStatus.Err() expected ErrDatabase, got %v", models.Schema.Err())
+	}
+}
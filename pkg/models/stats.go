@@ -0,0 +1,221 @@
+// SPDX-License-Identifier: GPL-2.0-or-later
+/*
+ * Copyright (C) 2026 SCANOSS.COM
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 2 of the License, or
+ * (at your option) any later version.
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+// Handle the summary statistics of the knowledge base contents
+
+package models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
+	"github.com/jmoiron/sqlx"
+)
+
+// StatsModel provides summary statistics about the contents of the knowledge base.
+type StatsModel struct {
+	db *sqlx.DB
+}
+
+// Stats summarises the contents of the knowledge base.
+type Stats struct {
+	TableCounts       map[string]int64 // Row count per table
+	Mines             []MineStats      // Project and URL counts per mine
+	Licenses          LicenseCoverage  // License coverage of the licenses and all_urls tables
+	Versions          VersionCoverage  // Version parsing coverage and release date range
+	DBVersions        []DBVersionInfo  // Versions from the db_version table. Empty if the table does not exist
+	SkippedDBVersions int64            // Rows of the db_version table left out of DBVersions because they could not be parsed
+}
+
+// MineStats holds the number of projects and URLs for a mine.
+type MineStats struct {
+	ID       int32  `db:"id"`
+	Name     string `db:"mine_name"`
+	PurlType string `db:"purl_type"`
+	Projects int64  `db:"projects"`
+	URLs     int64  `db:"urls"`
+}
+
+// LicenseCoverage holds the split of SPDX and non-SPDX licenses.
+type LicenseCoverage struct {
+	Licenses        int64 `db:"licenses"`          // Total rows in the licenses table
+	SpdxLicenses    int64 `db:"spdx_licenses"`     // Licenses with a valid SPDX identifier
+	NonSpdxLicenses int64 `db:"non_spdx_licenses"` // Licenses without a valid SPDX identifier
+	SpdxURLs        int64 `db:"spdx_urls"`         // URLs linked to an SPDX license
+	NonSpdxURLs     int64 `db:"non_spdx_urls"`     // URLs linked to a non-SPDX license
+	UnlicensedURLs  int64 `db:"unlicensed_urls"`   // URLs without a (known) license
+}
+
+// VersionCoverage holds the number of versions that can be parsed as semver, and the range of release dates.
+type VersionCoverage struct {
+	Versions       int64  // Total rows in the versions table
+	SemverChecked  int64  // Versions checked for semver parsing. At most versionSampleSize
+	SemverParsable int64  // Checked versions whose name or semver column can be parsed as semver
+	EarliestDate   string `db:"earliest"` // Earliest release date in the all_urls table
+	LatestDate     string `db:"latest"`   // Latest release date in the all_urls table
+}
+
+// versionSampleSize bounds the number of versions parsed in Go when measuring semver coverage.
+const versionSampleSize = 10000
+
+// NewStatsModel creates a new instance of the Stats Model.
+func NewStatsModel(db *sqlx.DB) *StatsModel {
+	return &StatsModel{db: db}
+}
+
+// GetStats gathers summary statistics about the knowledge base contents.
+// Sections relying on missing tables are left empty.
+// It counts the rows of every table, so is intended for offline reporting rather than request paths.
+func (m *StatsModel) GetStats(ctx context.Context) (Stats, error) {
+	s := ctxzap.Extract(ctx).Sugar()
+	info, err := Introspect(ctx, m.db)
+	if err != nil {
+		s.Errorf("Failed to introspect database for stats: %v", err)
+		return Stats{}, err
+	}
+	var stats Stats
+	if stats.TableCounts, err = m.tableCounts(ctx, info); err != nil {
+		return Stats{}, err
+	}
+	if info.HasTable("mines") && info.HasTable("projects") && info.HasTable("all_urls") {
+		if stats.Mines, err = m.mineStats(ctx); err != nil {
+			return Stats{}, err
+		}
+	}
+	if info.HasTable("licenses") && info.HasTable("all_urls") {
+		if stats.Licenses, err = m.licenseCoverage(ctx); err != nil {
+			return Stats{}, err
+		}
+	}
+	if info.HasTable("versions") && info.HasTable("all_urls") {
+		if stats.Versions, err = m.versionCoverage(ctx); err != nil {
+			return Stats{}, err
+		}
+	}
+	stats.DBVersions, err = NewDBVersionModel(m.db).GetVersions(ctx)
+	if err != nil && !errors.Is(err, ErrTableNotFound) {
+		return Stats{}, err
+	}
+	if skipped := stats.TableCounts["db_version"] - int64(len(stats.DBVersions)); skipped > 0 {
+		stats.SkippedDBVersions = skipped
+	}
+	return stats, nil
+}
+
+// tableCounts counts the rows in every table of the database.
+func (m *StatsModel) tableCounts(ctx context.Context, info SchemaInfo) (map[string]int64, error) {
+	tables := make([]string, 0, len(info.Tables))
+	for table := range info.Tables {
+		tables = append(tables, table)
+	}
+	sort.Strings(tables)
+	counts := make(map[string]int64, len(tables))
+	for _, table := range tables {
+		var count int64
+		// Table names come from sqlite_master, so are safe to quote into the query
+		err := m.db.QueryRowxContext(ctx, fmt.Sprintf("SELECT count(*) FROM %q", table)).Scan(&count) //nolint:gosec
+		if err != nil {
+			ctxzap.Extract(ctx).Sugar().Errorf("Failed to count rows in %v: %v", table, err)
+			return nil, dbError(fmt.Sprintf("failed to count rows in %v", table), err)
+		}
+		counts[table] = count
+	}
+	return counts, nil
+}
+
+// mineStats counts the projects and URLs for each mine.
+func (m *StatsModel) mineStats(ctx context.Context) ([]MineStats, error) {
+	var mines []MineStats
+	err := m.db.SelectContext(ctx, &mines,
+		"SELECT m.id, COALESCE(m.mine_name, '') AS mine_name, COALESCE(m.purl_type, '') AS purl_type,"+
+			" COALESCE(p.projects, 0) AS projects, COALESCE(u.urls, 0) AS urls FROM mines m"+
+			" LEFT JOIN (SELECT mine_id, count(*) AS projects FROM projects GROUP BY mine_id) p ON p.mine_id = m.id"+
+			" LEFT JOIN (SELECT mine_id, count(*) AS urls FROM all_urls GROUP BY mine_id) u ON u.mine_id = m.id"+
+			" ORDER BY m.id")
+	if err != nil {
+		ctxzap.Extract(ctx).Sugar().Errorf("Failed to query mine stats: %v", err)
+		return nil, dbError("failed to query mine stats", err)
+	}
+	return mines, nil
+}
+
+// licenseCoverage counts SPDX and non-SPDX licenses, and the URLs linked to them.
+func (m *StatsModel) licenseCoverage(ctx context.Context) (LicenseCoverage, error) {
+	var coverage LicenseCoverage
+	err := m.db.QueryRowxContext(ctx,
+		"SELECT (SELECT count(*) FROM licenses) AS licenses,"+
+			" (SELECT count(*) FROM licenses WHERE is_spdx) AS spdx_licenses,"+
+			" (SELECT count(*) FROM licenses WHERE NOT is_spdx) AS non_spdx_licenses,"+
+			" (SELECT count(*) FROM all_urls u JOIN licenses l ON u.license_id = l.id WHERE l.is_spdx) AS spdx_urls,"+
+			" (SELECT count(*) FROM all_urls u JOIN licenses l ON u.license_id = l.id WHERE NOT l.is_spdx) AS non_spdx_urls,"+
+			" (SELECT count(*) FROM all_urls u LEFT JOIN licenses l ON u.license_id = l.id WHERE l.id IS NULL) AS unlicensed_urls",
+	).StructScan(&coverage)
+	if err != nil {
+		ctxzap.Extract(ctx).Sugar().Errorf("Failed to query license coverage: %v", err)
+		return LicenseCoverage{}, dbError("failed to query license coverage", err)
+	}
+	return coverage, nil
+}
+
+// versionCoverage counts the versions, and finds the range of release dates.
+// Semver parsing is checked on a sample of at most versionSampleSize versions.
+func (m *StatsModel) versionCoverage(ctx context.Context) (VersionCoverage, error) {
+	s := ctxzap.Extract(ctx).Sugar()
+	var coverage VersionCoverage
+	err := m.db.QueryRowxContext(ctx,
+		"SELECT COALESCE(MIN(date), '') AS earliest, COALESCE(MAX(date), '') AS latest"+
+			" FROM all_urls WHERE date IS NOT NULL AND date != ''",
+	).StructScan(&coverage)
+	if err != nil {
+		s.Errorf("Failed to query release date range: %v", err)
+		return VersionCoverage{}, dbError("failed to query release date range", err)
+	}
+	if err = m.db.QueryRowxContext(ctx, "SELECT count(*) FROM versions").Scan(&coverage.Versions); err != nil {
+		s.Errorf("Failed to count versions: %v", err)
+		return VersionCoverage{}, dbError("failed to count versions", err)
+	}
+	rows, err := m.db.QueryxContext(ctx,
+		"SELECT version_name, COALESCE(semver, '') AS semver FROM versions LIMIT ?", versionSampleSize)
+	if err != nil {
+		s.Errorf("Failed to query versions table: %v", err)
+		return VersionCoverage{}, dbError("failed to query the versions table", err)
+	}
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			s.Warnf("Problem closing Rows: %v", closeErr)
+		}
+	}()
+	for rows.Next() {
+		var version Version
+		if err = rows.StructScan(&version); err != nil {
+			return VersionCoverage{}, dbError("failed to parse versions table results", err)
+		}
+		coverage.SemverChecked++
+		if _, vErr := semver.NewVersion(version.VersionName); vErr == nil {
+			coverage.SemverParsable++
+		} else if _, vErr = semver.NewVersion(version.SemVer); vErr == nil {
+			coverage.SemverParsable++
+		}
+	}
+	if err = rows.Err(); err != nil {
+		return VersionCoverage{}, dbError("failed to iterate the versions table", err)
+	}
+	return coverage, nil
+}
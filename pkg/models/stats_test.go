@@ -0,0 +1,109 @@
+// SPDX-License-Identifier: GPL-2.0-or-later
+/*
+ * Copyright (C) 2026 SCANOSS.COM
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 2 of the License, or
+ * (at your option) any later version.
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
+	"github.com/scanoss/go-models/internal/testutils"
+	zlog "github.com/scanoss/zap-logging-helper/pkg/logger"
+)
+
+func TestGetStats(t *testing.T) {
+	err := zlog.NewSugaredDevLogger()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a sugared logger", err)
+	}
+	defer zlog.SyncZap()
+	ctx := ctxzap.ToContext(context.Background(), zlog.L)
+	db := testutils.SqliteSetup(t)
+	defer testutils.CloseDB(t, db)
+	testutils.LoadMockSQLData(t, db, "../../internal/testutils/mock")
+	testutils.LoadSQLDataFile(t, db, "../../internal/testutils/mock/db_version.sql")
+
+	statsModel := NewStatsModel(db)
+	stats, err := statsModel.GetStats(ctx)
+	if err != nil {
+		t.Fatalf("stats.GetStats() error = %v", err)
+	}
+	fmt.Printf("Stats: %#v\n", stats)
+
+	if stats.TableCounts["licenses"] != 11 || stats.TableCounts["db_version"] != 2 {
+		t.Errorf("stats.GetStats() unexpected table counts: %v", stats.TableCounts)
+	}
+	var rubygems *MineStats
+	for i := range stats.Mines {
+		if stats.Mines[i].ID == 1 {
+			rubygems = &stats.Mines[i]
+		}
+	}
+	if rubygems == nil || rubygems.Name != "rubygems.org" || rubygems.Projects != 1 || rubygems.URLs != 9 {
+		t.Errorf("stats.GetStats() unexpected rubygems stats: %#v", rubygems)
+	}
+	lic := stats.Licenses
+	if lic.Licenses != 11 || lic.SpdxLicenses != 10 || lic.NonSpdxLicenses != 1 {
+		t.Errorf("stats.GetStats() unexpected license coverage: %#v", lic)
+	}
+	if lic.SpdxURLs+lic.NonSpdxURLs+lic.UnlicensedURLs != stats.TableCounts["all_urls"] {
+		t.Errorf("stats.GetStats() license coverage does not add up to the all_urls count: %#v", lic)
+	}
+	ver := stats.Versions
+	if ver.Versions != stats.TableCounts["versions"] || ver.SemverChecked != ver.Versions || ver.SemverParsable == 0 || ver.SemverParsable > ver.SemverChecked {
+		t.Errorf("stats.GetStats() unexpected version coverage: %#v", ver)
+	}
+	if len(ver.EarliestDate) == 0 || ver.EarliestDate > ver.LatestDate {
+		t.Errorf("stats.GetStats() unexpected date range: %v - %v", ver.EarliestDate, ver.LatestDate)
+	}
+	if len(stats.DBVersions) != 2 || stats.SkippedDBVersions != 0 {
+		t.Errorf("stats.GetStats() expected 2 db versions, got %v (%v skipped)", len(stats.DBVersions), stats.SkippedDBVersions)
+	}
+
+	_, err = db.Exec("INSERT INTO db_version (package_name, schema_version, created_at, db_release) VALUES ('bad', 'x', '2026-03-01', '2026.03')")
+	if err != nil {
+		t.Fatalf("failed to insert db_version row: %v", err)
+	}
+	stats, err = statsModel.GetStats(ctx)
+	if err != nil {
+		t.Fatalf("stats.GetStats() error = %v", err)
+	}
+	if len(stats.DBVersions) != 2 || stats.SkippedDBVersions != 1 {
+		t.Errorf("stats.GetStats() expected the unparsable db version to be skipped, got %v (%v skipped)", len(stats.DBVersions), stats.SkippedDBVersions)
+	}
+}
+
+// TestGetStatsEmptyDB tests gathering stats without any tables loaded.
+func TestGetStatsEmptyDB(t *testing.T) {
+	err := zlog.NewSugaredDevLogger()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a sugared logger", err)
+	}
+	defer zlog.SyncZap()
+	ctx := ctxzap.ToContext(context.Background(), zlog.L)
+	db := testutils.SqliteSetup(t)
+	defer testutils.CloseDB(t, db)
+
+	stats, err := NewStatsModel(db).GetStats(ctx)
+	if err != nil {
+		t.Errorf("stats.GetStats() error = %v", err)
+	}
+	if len(stats.TableCounts) > 0 || len(stats.Mines) > 0 || len(stats.DBVersions) > 0 {
+		t.Errorf("stats.GetStats() expected empty stats, got %#v", stats)
+	}
+}
@@ -0,0 +1,130 @@
+// SPDX-License-Identifier: GPL-2.0-or-later
+/*
+ * Copyright (C) 2026 SCANOSS.COM
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 2 of the License, or
+ * (at your option) any later version.
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package models
+
+import (
+	"strings"
+	"time"
+)
+
+// URLOrder selects the ordering of all_urls query results.
+type URLOrder int
+
+const (
+	URLOrderDateDesc URLOrder = iota // Newest first (default)
+	URLOrderDateAsc                  // Oldest first
+)
+
+// URLQueryOptions narrows down all_urls queries. The zero value applies no filters and orders newest first.
+type URLQueryOptions struct {
+	MineIDs    []int32  // Only rows from one of these mines (nil for any). 0 is a valid mine ID
+	MineName   string   // Only rows from the mine with this name (e.g. fedoraproject.org)
+	DateFrom   string   // Only rows released on or after this date (YYYY-MM-DD)
+	DateTo     string   // Only rows released on or before this date (YYYY-MM-DD)
+	LicenseID  int32    // Only rows declaring this license ID (0 for any)
+	SPDXOnly   bool     // Only rows declaring an SPDX license
+	FileType   string   // Only rows whose download URL has this file extension (e.g. jar)
+	Classifier string   // Only rows whose download file name ends with this classifier (e.g. sources for x-1.0-sources.jar)
+	Order      URLOrder // Result ordering
+}
+
+// urlDateLayout is the layout of the all_urls date column.
+const urlDateLayout = "2006-01-02"
+
+// validate checks the options are well-formed.
+func (o URLQueryOptions) validate() error {
+	for _, d := range []string{o.DateFrom, o.DateTo} {
+		if len(d) == 0 {
+			continue
+		}
+		if _, err := time.Parse(urlDateLayout, d); err != nil {
+			return invalidInput("invalid date, expected YYYY-MM-DD: " + d)
+		}
+	}
+	if len(o.DateFrom) > 0 && len(o.DateTo) > 0 && o.DateFrom > o.DateTo {
+		return invalidInput("date range starts after it ends")
+	}
+	if o.LicenseID < 0 {
+		return invalidInput("license ID cannot be negative")
+	}
+	for _, id := range o.MineIDs {
+		if id < 0 {
+			return invalidInput("mine IDs cannot be negative")
+		}
+	}
+	if o.Order != URLOrderDateDesc && o.Order != URLOrderDateAsc {
+		return invalidInput("unknown result ordering")
+	}
+	return nil
+}
+
+// where returns the filter conditions (prefixed with AND) and their arguments,
+// for a query joining all_urls u with mines m and licenses l.
+func (o URLQueryOptions) where() (string, []any) {
+	var conds []string
+	var args []any
+	if len(o.MineIDs) > 0 {
+		conds = append(conds, "u.mine_id IN (?"+strings.Repeat(", ?", len(o.MineIDs)-1)+")")
+		for _, id := range o.MineIDs {
+			args = append(args, id)
+		}
+	}
+	if len(o.MineName) > 0 {
+		conds = append(conds, "m.mine_name = ?")
+		args = append(args, o.MineName)
+	}
+	if len(o.DateFrom) > 0 {
+		conds = append(conds, "u.date >= ?")
+		args = append(args, o.DateFrom)
+	}
+	if len(o.DateTo) > 0 {
+		conds = append(conds, "u.date <= ?")
+		args = append(args, o.DateTo)
+	}
+	if o.LicenseID > 0 {
+		conds = append(conds, "u.license_id = ?")
+		args = append(args, o.LicenseID)
+	}
+	if o.SPDXOnly {
+		conds = append(conds, "COALESCE(l.is_spdx, false) = true")
+	}
+	if len(o.FileType) > 0 {
+		conds = append(conds, `u.url LIKE ? ESCAPE '\'`)
+		args = append(args, "%."+escapeLike(o.FileType))
+	}
+	if len(o.Classifier) > 0 {
+		conds = append(conds, `u.url LIKE ? ESCAPE '\'`)
+		args = append(args, "%-"+escapeLike(o.Classifier)+".%")
+	}
+	if len(conds) == 0 {
+		return "", nil
+	}
+	return " AND " + strings.Join(conds, " AND "), args
+}
+
+// direction returns the SQL sort direction of the ordering.
+func (o URLQueryOptions) direction() string {
+	if o.Order == URLOrderDateAsc {
+		return "ASC"
+	}
+	return "DESC"
+}
+
+// escapeLike escapes the LIKE wildcards of a value, for use with ESCAPE '\'.
+func escapeLike(value string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(value)
+}
@@ -0,0 +1,73 @@
+// SPDX-License-Identifier: GPL-2.0-or-later
+/*
+ * Copyright (C) 2026 SCANOSS.COM
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 2 of the License, or
+ * (at your option) any later version.
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package models
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestURLQueryOptionsValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    URLQueryOptions
+		wantErr bool
+	}{
+		{name: "zero value", opts: URLQueryOptions{}},
+		{name: "all filters", opts: URLQueryOptions{MineIDs: []int32{2}, MineName: "npmjs.org", DateFrom: "2020-01-01", DateTo: "2020-12-31", LicenseID: 5614, SPDXOnly: true, Order: URLOrderDateAsc}},
+		{name: "single day", opts: URLQueryOptions{DateFrom: "2020-01-01", DateTo: "2020-01-01"}},
+		{name: "invalid date", opts: URLQueryOptions{DateFrom: "01/01/2020"}, wantErr: true},
+		{name: "reversed range", opts: URLQueryOptions{DateFrom: "2021-01-01", DateTo: "2020-01-01"}, wantErr: true},
+		{name: "negative mine", opts: URLQueryOptions{MineIDs: []int32{-1}}, wantErr: true},
+		{name: "mine list", opts: URLQueryOptions{MineIDs: []int32{0, 15}}},
+		{name: "negative mine in list", opts: URLQueryOptions{MineIDs: []int32{0, -15}}, wantErr: true},
+		{name: "unknown order", opts: URLQueryOptions{Order: URLOrder(42)}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.opts.validate()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrInvalidInput) {
+				t.Errorf("validate() error = %v, want %v", err, ErrInvalidInput)
+			}
+		})
+	}
+}
+
+func TestURLQueryOptionsWhere(t *testing.T) {
+	if filter, args := (URLQueryOptions{}).where(); len(filter) > 0 || len(args) > 0 {
+		t.Errorf("where() = %q, %v, want no conditions", filter, args)
+	}
+	filter, args := URLQueryOptions{MineIDs: []int32{0, 15}, MineName: "npmjs.org", DateFrom: "2020-01-01", SPDXOnly: true}.where()
+	want := " AND u.mine_id IN (?, ?) AND m.mine_name = ? AND u.date >= ? AND COALESCE(l.is_spdx, false) = true"
+	if filter != want || len(args) != 4 || args[0] != int32(0) || args[2] != "npmjs.org" || args[3] != "2020-01-01" {
+		t.Errorf("where() = %q, %v, want %q", filter, args, want)
+	}
+}
+
+func TestURLQueryOptionsArtifactFilters(t *testing.T) {
+	filter, args := URLQueryOptions{FileType: "jar", Classifier: "test_sources"}.where()
+	want := ` AND u.url LIKE ? ESCAPE '\' AND u.url LIKE ? ESCAPE '\'`
+	if filter != want || len(args) != 2 || args[0] != "%.jar" || args[1] != `%-test\_sources.%` {
+		t.Errorf("where() = %q, %v, want %q", filter, args, want)
+	}
+	if got := escapeLike(`100%_\`); got != `100\%\_\\` {
+		t.Errorf("escapeLike() = %v", got)
+	}
+}
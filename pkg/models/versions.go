@@ -29,7 +29,8 @@ import (
 )
 
 type VersionModel struct {
-	db *sqlx.DB
+	db     *sqlx.DB
+	compat *SchemaStatus // Schema compatibility recorded by NewModels. Nil allows every query
 }
 
 type Version struct {
@@ -44,20 +45,27 @@ func NewVersionModel(db *sqlx.DB) *VersionModel {
 }
 
 // GetVersionByName gets the given version from the versions table.
+// Returns ErrNotFound if there is no version with that name.
 func (m *VersionModel) GetVersionByName(ctx context.Context, name string) (Version, error) {
+	if err := checkSchema(m.compat, ModelVersions); err != nil {
+		return Version{}, err
+	}
 	s := ctxzap.Extract(ctx).Sugar()
 	if len(name) == 0 {
 		s.Error("Please specify a valid Version Name to query")
-		return Version{}, errors.New("please specify a valid Version Name to query")
+		return Version{}, invalidInput("please specify a valid Version Name to query")
 	}
 	var version Version
 	err := m.db.QueryRowxContext(ctx,
-		"SELECT id, version_name, semver FROM versions"+
+		"SELECT id, version_name, COALESCE(semver, '') AS semver FROM versions"+
 			" WHERE version_name = $1",
 		name).StructScan(&version)
-	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+	if errors.Is(err, sql.ErrNoRows) {
+		return Version{}, fmt.Errorf("%w: no version named %q", ErrNotFound, name)
+	}
+	if err != nil {
 		s.Errorf("Error: Failed to query versions table for %v: %v", name, err)
-		return Version{}, fmt.Errorf("failed to query the versions table: %v", err)
+		return Version{}, dbError("failed to query the versions table", err)
 	}
 
 	return version, nil
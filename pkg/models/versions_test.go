@@ -18,6 +18,7 @@ package models
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"testing"
 
@@ -61,14 +62,10 @@ func TestVersionsSearch(t *testing.T) {
 
 	name = "22.22.22"
 	fmt.Printf("Searching for version: %v\n", name)
-	version, err = versionModel.GetVersionByName(ctx, name)
-	if err != nil {
-		t.Errorf("versions.GetVersionByName() error = %v", err)
-	}
-	if len(version.VersionName) > 0 {
-		t.Errorf("versions.GetVersionByName() - Expected no version returned from query")
+	_, err = versionModel.GetVersionByName(ctx, name)
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("versions.GetVersionByName() error = %v, want %v", err, ErrNotFound)
 	}
-	fmt.Printf("Version: %#v\n", version)
 }
 
 // TestVersionsSearchBadSql test queries without creating/loading the versions table.
@@ -90,3 +87,24 @@ func TestVersionsSearchBadSql(t *testing.T) {
 		fmt.Printf("Got expected error = %v\n", err)
 	}
 }
+
+func TestVersionsNullSemver(t *testing.T) {
+	err := zlog.NewSugaredDevLogger()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a sugared logger", err)
+	}
+	defer zlog.SyncZap()
+	ctx := ctxzap.ToContext(context.Background(), zlog.L)
+	db := testutils.SqliteSetup(t) // Setup SQL Lite DB
+	defer testutils.CloseDB(t, db)
+	testutils.LoadMockSQLData(t, db, "../../internal/testutils/mock")
+	testutils.LoadSQLDataFile(t, db, "../../internal/testutils/mock/dangling_ids.sql")
+
+	version, err := NewVersionModel(db).GetVersionByName(ctx, "7.7.7-dangling")
+	if err != nil {
+		t.Fatalf("versions.GetVersionByName() error = %v", err)
+	}
+	if version.ID != 77777778 || len(version.SemVer) > 0 {
+		t.Errorf("versions.GetVersionByName() unexpected version: %#v", version)
+	}
+}
@@ -0,0 +1,170 @@
+// SPDX-License-Identifier: GPL-2.0-or-later
+/*
+ * Copyright (C) 2026 SCANOSS.COM
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 2 of the License, or
+ * (at your option) any later version.
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+// Package cyclonedx reads and writes CycloneDX JSON SBOMs, and enriches their components
+// with the versions, licenses and project URLs held in a SCANOSS knowledge base.
+//
+// Only the parts of the specification needed for enrichment are modelled.
+// Any other members are preserved as-is, so a document survives a Read/Write round trip.
+package cyclonedx
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/scanoss/go-models/pkg/sbom/internal/jsonobject"
+)
+
+// BOMFormat is the bomFormat value of every CycloneDX document.
+const BOMFormat = "CycloneDX"
+
+// ExternalReferenceWebsite is the external reference type used for project URLs.
+const ExternalReferenceWebsite = "website"
+
+// BOM represents a CycloneDX document.
+type BOM struct {
+	BOMFormat    string      `json:"bomFormat"`
+	SpecVersion  string      `json:"specVersion"`
+	SerialNumber string      `json:"serialNumber,omitempty"`
+	Version      int         `json:"version,omitempty"`
+	Components   []Component `json:"components,omitempty"`
+	extra        jsonobject.Extra
+}
+
+// Component represents a CycloneDX component.
+type Component struct {
+	Type               string              `json:"type,omitempty"`
+	BOMRef             string              `json:"bom-ref,omitempty"`
+	Group              string              `json:"group,omitempty"`
+	Name               string              `json:"name,omitempty"`
+	Version            string              `json:"version,omitempty"`
+	Purl               string              `json:"purl,omitempty"`
+	Licenses           []LicenseChoice     `json:"licenses,omitempty"`
+	ExternalReferences []ExternalReference `json:"externalReferences,omitempty"`
+	Components         []Component         `json:"components,omitempty"`
+	extra              jsonobject.Extra
+}
+
+// LicenseChoice represents either a single license or an SPDX license expression.
+type LicenseChoice struct {
+	License    *License `json:"license,omitempty"`
+	Expression string   `json:"expression,omitempty"`
+	extra      jsonobject.Extra
+}
+
+// License represents a license, identified either by SPDX ID or by name.
+type License struct {
+	ID    string `json:"id,omitempty"`
+	Name  string `json:"name,omitempty"`
+	URL   string `json:"url,omitempty"`
+	extra jsonobject.Extra
+}
+
+// ExternalReference represents a reference to an external resource, such as a project website.
+type ExternalReference struct {
+	Type    string `json:"type"`
+	URL     string `json:"url"`
+	Comment string `json:"comment,omitempty"`
+	extra   jsonobject.Extra
+}
+
+// Read decodes a CycloneDX JSON document.
+func Read(r io.Reader) (*BOM, error) {
+	var bom BOM
+	if err := json.NewDecoder(r).Decode(&bom); err != nil {
+		return nil, fmt.Errorf("failed to decode CycloneDX document: %w", err)
+	}
+	if bom.BOMFormat != BOMFormat {
+		return nil, fmt.Errorf("unsupported bomFormat: %q", bom.BOMFormat)
+	}
+	return &bom, nil
+}
+
+// Write encodes a CycloneDX document as indented JSON.
+func Write(w io.Writer, bom *BOM) error {
+	if bom == nil {
+		return errors.New("no CycloneDX document to write")
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(bom); err != nil {
+		return fmt.Errorf("failed to encode CycloneDX document: %w", err)
+	}
+	return nil
+}
+
+func (b *BOM) UnmarshalJSON(data []byte) error {
+	type alias BOM
+	extra, err := jsonobject.Unmarshal(data, (*alias)(b))
+	b.extra = extra
+	return err
+}
+
+func (b BOM) MarshalJSON() ([]byte, error) {
+	type alias BOM
+	return jsonobject.Marshal(alias(b), b.extra)
+}
+
+func (c *Component) UnmarshalJSON(data []byte) error {
+	type alias Component
+	extra, err := jsonobject.Unmarshal(data, (*alias)(c))
+	c.extra = extra
+	return err
+}
+
+func (c Component) MarshalJSON() ([]byte, error) {
+	type alias Component
+	return jsonobject.Marshal(alias(c), c.extra)
+}
+
+func (l *LicenseChoice) UnmarshalJSON(data []byte) error {
+	type alias LicenseChoice
+	extra, err := jsonobject.Unmarshal(data, (*alias)(l))
+	l.extra = extra
+	return err
+}
+
+func (l LicenseChoice) MarshalJSON() ([]byte, error) {
+	type alias LicenseChoice
+	return jsonobject.Marshal(alias(l), l.extra)
+}
+
+func (l *License) UnmarshalJSON(data []byte) error {
+	type alias License
+	extra, err := jsonobject.Unmarshal(data, (*alias)(l))
+	l.extra = extra
+	return err
+}
+
+func (l License) MarshalJSON() ([]byte, error) {
+	type alias License
+	return jsonobject.Marshal(alias(l), l.extra)
+}
+
+func (r *ExternalReference) UnmarshalJSON(data []byte) error {
+	type alias ExternalReference
+	extra, err := jsonobject.Unmarshal(data, (*alias)(r))
+	r.extra = extra
+	return err
+}
+
+func (r ExternalReference) MarshalJSON() ([]byte, error) {
+	type alias ExternalReference
+	return jsonobject.Marshal(alias(r), r.extra)
+}
@@ -0,0 +1,102 @@
+// SPDX-License-Identifier: GPL-2.0-or-later
+/*
+ * Copyright (C) 2026 SCANOSS.COM
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 2 of the License, or
+ * (at your option) any later version.
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package cyclonedx
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const testBOM = `{
+  "bomFormat": "CycloneDX",
+  "specVersion": "1.5",
+  "serialNumber": "urn:uuid:3e671687-395b-41f5-a30f-a58921a69b79",
+  "version": 1,
+  "metadata": {"timestamp": "2026-01-01T00:00:00Z", "component": {"type": "application", "name": "demo"}},
+  "components": [
+    {
+      "type": "library",
+      "bom-ref": "react",
+      "name": "react",
+      "version": "^15.0.0",
+      "purl": "pkg:npm/react",
+      "hashes": [{"alg": "SHA-1", "content": "ddc0045d66061c042cc150e0f1b1e229"}],
+      "licenses": [{"license": {"id": "MIT", "acknowledgement": "declared"}}],
+      "properties": [{"name": "scope", "value": "runtime"}]
+    },
+    {
+      "type": "library",
+      "bom-ref": "electron-updater",
+      "name": "electron-updater",
+      "purl": "pkg:npm/electron-updater@4.0.8",
+      "externalReferences": [{"type": "vcs", "url": "https://github.com/electron-userland/electron-builder", "hashes": []}],
+      "components": [{"type": "library", "name": "unknown", "purl": "pkg:npm/does-not-exist-anywhere"}]
+    }
+  ],
+  "dependencies": [{"ref": "react", "dependsOn": []}]
+}`
+
+func TestReadWrite(t *testing.T) {
+	bom, err := Read(strings.NewReader(testBOM))
+	if err != nil {
+		t.Fatalf("an error '%v' was not expected when reading the BOM", err)
+	}
+	if bom.SpecVersion != "1.5" || len(bom.Components) != 2 || len(bom.Components[1].Components) != 1 {
+		t.Errorf("Read() unexpected document: %#v", bom)
+	}
+	if bom.Components[0].Licenses[0].License.ID != "MIT" {
+		t.Errorf("Read() license = %#v, want MIT", bom.Components[0].Licenses[0].License)
+	}
+	var buf bytes.Buffer
+	if err = Write(&buf, bom); err != nil {
+		t.Fatalf("an error '%v' was not expected when writing the BOM", err)
+	}
+	var want, got any
+	if err = json.Unmarshal([]byte(testBOM), &want); err != nil {
+		t.Fatalf("failed to decode test BOM: %v", err)
+	}
+	if err = json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("failed to decode written BOM: %v", err)
+	}
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("Write() did not round trip the document:\n%v", buf.String())
+	}
+}
+
+func TestReadInvalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{name: "not json", input: "bomFormat: CycloneDX"},
+		{name: "wrong format", input: `{"spdxVersion": "SPDX-2.3"}`},
+		{name: "bad component", input: `{"bomFormat": "CycloneDX", "components": [{"name": 1}]}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Read(strings.NewReader(tt.input)); err == nil {
+				t.Errorf("Read() expected an error for %v", tt.input)
+			}
+		})
+	}
+	if err := Write(&bytes.Buffer{}, nil); err == nil {
+		t.Errorf("Write() expected an error for a nil document")
+	}
+}
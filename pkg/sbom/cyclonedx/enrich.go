@@ -0,0 +1,155 @@
+// SPDX-License-Identifier: GPL-2.0-or-later
+/*
+ * Copyright (C) 2026 SCANOSS.COM
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 2 of the License, or
+ * (at your option) any later version.
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package cyclonedx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
+	"github.com/scanoss/go-models/pkg/services"
+	"github.com/scanoss/go-models/pkg/types"
+	purlutils "github.com/scanoss/go-purl-helper/pkg"
+)
+
+var spdxIDRegex = regexp.MustCompile(`^[A-Za-z0-9.+-]+$`) // regex to match a single SPDX license identifier
+
+// Enricher fills in missing component versions, licenses and project URLs using the component service.
+type Enricher struct {
+	component *services.ComponentService
+}
+
+// Summary reports the outcome of enriching a document.
+type Summary struct {
+	Components int       // Number of components with a purl
+	Enriched   int       // Number of components updated
+	Failures   []Failure // Components that could not be resolved
+}
+
+// Failure records a component that could not be resolved.
+type Failure struct {
+	BOMRef string
+	Purl   string
+	Err    error
+}
+
+// NewEnricher creates a new CycloneDX enricher using the given component service.
+func NewEnricher(component *services.ComponentService) *Enricher {
+	return &Enricher{component: component}
+}
+
+// Enrich resolves every component (including nested components) that has a purl.
+//
+// A component without a version in its purl is resolved using its version field as the requirement,
+// and both the purl and version are updated with the resolved version. Licenses are only added to
+// components without any, and the project URL is added as a website external reference.
+// Components that cannot be resolved are reported in the summary rather than failing the whole document.
+func (e *Enricher) Enrich(ctx context.Context, bom *BOM) (Summary, error) {
+	var summary Summary
+	if bom == nil {
+		return summary, errors.New("no CycloneDX document to enrich")
+	}
+	err := e.enrichComponents(ctx, bom.Components, &summary)
+	return summary, err
+}
+
+// enrichComponents enriches the given components and their nested components.
+func (e *Enricher) enrichComponents(ctx context.Context, components []Component, summary *Summary) error {
+	s := ctxzap.Extract(ctx).Sugar()
+	for i := range components {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		c := &components[i]
+		if len(c.Purl) > 0 {
+			summary.Components++
+			changed, err := e.enrichComponent(ctx, c)
+			switch {
+			case err != nil:
+				s.Debugf("Failed to enrich component %v: %v", c.Purl, err)
+				summary.Failures = append(summary.Failures, Failure{BOMRef: c.BOMRef, Purl: c.Purl, Err: err})
+			case changed:
+				summary.Enriched++
+			}
+		}
+		if err := e.enrichComponents(ctx, c.Components, summary); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// enrichComponent resolves a single component, reporting whether it was updated.
+func (e *Enricher) enrichComponent(ctx context.Context, c *Component) (bool, error) {
+	purl, err := purlutils.PurlFromString(c.Purl)
+	if err != nil {
+		return false, fmt.Errorf("failed to parse purl: %w", err)
+	}
+	req := types.ComponentRequest{Purl: c.Purl}
+	if len(purl.Version) == 0 {
+		req.Requirement = c.Version // may be an exact version or a range
+	}
+	res, err := e.component.GetComponent(ctx, req)
+	if err != nil {
+		return false, err
+	}
+	var changed bool
+	switch {
+	case len(purl.Version) == 0:
+		purl.Version = res.Version
+		c.Purl = purl.ToString()
+		c.Version = res.Version
+		changed = true
+	case len(c.Version) == 0:
+		c.Version = res.Version
+		changed = true
+	}
+	if len(c.Licenses) == 0 && len(res.Licenses) > 0 {
+		c.Licenses = licenseChoices(res.Licenses)
+		changed = true
+	}
+	if len(res.URL) > 0 && !hasExternalReference(c, res.URL) {
+		c.ExternalReferences = append(c.ExternalReferences, ExternalReference{Type: ExternalReferenceWebsite, URL: res.URL})
+		changed = true
+	}
+	return changed, nil
+}
+
+// licenseChoices converts knowledge base licenses to CycloneDX licenses, using the SPDX ID where valid.
+func licenseChoices(licenses []types.License) []LicenseChoice {
+	choices := make([]LicenseChoice, 0, len(licenses))
+	for _, l := range licenses {
+		if l.IsSpdx && spdxIDRegex.MatchString(l.SPDXID) {
+			choices = append(choices, LicenseChoice{License: &License{ID: l.SPDXID}})
+		} else {
+			choices = append(choices, LicenseChoice{License: &License{Name: l.Name}})
+		}
+	}
+	return choices
+}
+
+// hasExternalReference reports whether the component already references the given URL.
+func hasExternalReference(c *Component, url string) bool {
+	for _, r := range c.ExternalReferences {
+		if r.URL == url {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,109 @@
+// SPDX-License-Identifier: GPL-2.0-or-later
+/*
+ * Copyright (C) 2026 SCANOSS.COM
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 2 of the License, or
+ * (at your option) any later version.
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package cyclonedx
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/scanoss/go-models/internal/testutils"
+	"github.com/scanoss/go-models/pkg/models"
+	"github.com/scanoss/go-models/pkg/services"
+	"github.com/scanoss/go-models/pkg/types"
+	zlog "github.com/scanoss/zap-logging-helper/pkg/logger"
+)
+
+func TestEnrich(t *testing.T) {
+	err := zlog.NewSugaredDevLogger()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a sugared logger", err)
+	}
+	defer zlog.SyncZap()
+	ctx := context.Background()
+	db := testutils.SqliteSetup(t) // Setup SQL Lite DB
+	defer testutils.CloseDB(t, db)
+	testutils.LoadMockSQLData(t, db, "../../../internal/testutils/mock")
+	enricher := NewEnricher(services.NewComponentService(models.NewModels(db)))
+
+	bom, err := Read(strings.NewReader(testBOM))
+	if err != nil {
+		t.Fatalf("an error '%v' was not expected when reading the BOM", err)
+	}
+	summary, err := enricher.Enrich(ctx, bom)
+	if err != nil {
+		t.Fatalf("an error '%v' was not expected when enriching the BOM", err)
+	}
+	if summary.Components != 3 || summary.Enriched != 2 || len(summary.Failures) != 1 {
+		t.Errorf("Enrich() summary = %+v, want 3 components, 2 enriched and 1 failure", summary)
+	}
+	if len(summary.Failures) == 1 && summary.Failures[0].Purl != "pkg:npm/does-not-exist-anywhere" {
+		t.Errorf("Enrich() unexpected failure: %+v", summary.Failures[0])
+	}
+
+	react := bom.Components[0]
+	if react.Version != "15.7.0" || react.Purl != "pkg:npm/react@15.7.0" {
+		t.Errorf("Enrich() react version = %v, purl = %v, want 15.7.0", react.Version, react.Purl)
+	}
+	if len(react.Licenses) != 1 || react.Licenses[0].License.extra == nil {
+		t.Errorf("Enrich() should not replace existing licenses: %#v", react.Licenses)
+	}
+	if len(react.ExternalReferences) != 1 || react.ExternalReferences[0].Type != ExternalReferenceWebsite {
+		t.Errorf("Enrich() react external references = %#v", react.ExternalReferences)
+	}
+
+	updater := bom.Components[1]
+	if updater.Version != "4.0.8" || updater.Purl != "pkg:npm/electron-updater@4.0.8" {
+		t.Errorf("Enrich() electron-updater version = %v, purl = %v, want 4.0.8", updater.Version, updater.Purl)
+	}
+	if len(updater.Licenses) != 1 || updater.Licenses[0].License.ID != "MIT" {
+		t.Errorf("Enrich() electron-updater licenses = %#v, want MIT", updater.Licenses)
+	}
+	if len(updater.ExternalReferences) != 2 {
+		t.Errorf("Enrich() electron-updater external references = %#v, want 2", updater.ExternalReferences)
+	}
+	if updater.Components[0].Version != "" {
+		t.Errorf("Enrich() unresolved component should be left unchanged: %#v", updater.Components[0])
+	}
+
+	summary, err = enricher.Enrich(ctx, bom)
+	if err != nil || summary.Enriched != 0 {
+		t.Errorf("Enrich() should be idempotent: %+v, %v", summary, err)
+	}
+	if _, err = enricher.Enrich(ctx, nil); err == nil {
+		t.Errorf("Enrich() expected an error for a nil document")
+	}
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	if _, err = enricher.Enrich(cancelled, bom); err == nil {
+		t.Errorf("Enrich() expected an error for a cancelled context")
+	}
+}
+
+func TestLicenseChoices(t *testing.T) {
+	choices := licenseChoices([]types.License{
+		{Name: "MIT", SPDXID: "MIT", IsSpdx: true},
+		{Name: "GPL-2 or GPL-3", SPDXID: "GPL-2.0-only/GPL-3.0-only", IsSpdx: true},
+		{Name: "Custom", IsSpdx: false},
+	})
+	if len(choices) != 3 {
+		t.Fatalf("licenseChoices() = %v, want 3 choices", len(choices))
+	}
+	if choices[0].License.ID != "MIT" || choices[1].License.Name != "GPL-2 or GPL-3" || choices[2].License.Name != "Custom" {
+		t.Errorf("licenseChoices() unexpected result: %+v %+v %+v", choices[0].License, choices[1].License, choices[2].License)
+	}
+}
@@ -0,0 +1,108 @@
+// SPDX-License-Identifier: GPL-2.0-or-later
+/*
+ * Copyright (C) 2026 SCANOSS.COM
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 2 of the License, or
+ * (at your option) any later version.
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+// Package jsonobject encodes and decodes JSON objects while preserving the members not modelled by a struct.
+package jsonobject
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Extra holds the JSON object members not modelled by a struct, so they survive a read/write round trip.
+type Extra map[string]json.RawMessage
+
+// knownFields caches the JSON member names of each struct type.
+var knownFields sync.Map
+
+// jsonFields returns the JSON member names of the given struct type.
+func jsonFields(t reflect.Type) map[string]bool {
+	if cached, ok := knownFields.Load(t); ok {
+		if fields, isMap := cached.(map[string]bool); isMap {
+			return fields
+		}
+	}
+	fields := make(map[string]bool, t.NumField())
+	for i := range t.NumField() {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if len(name) == 0 {
+			name = f.Name
+		}
+		fields[name] = true
+	}
+	knownFields.Store(t, fields)
+	return fields
+}
+
+// Unmarshal decodes data into v (a pointer to a struct without JSON methods), returning the members not modelled by v.
+func Unmarshal(data []byte, v any) (Extra, error) {
+	if err := json.Unmarshal(data, v); err != nil {
+		return nil, err
+	}
+	var members map[string]json.RawMessage
+	if err := json.Unmarshal(data, &members); err != nil {
+		return nil, err
+	}
+	known := jsonFields(reflect.TypeOf(v).Elem())
+	var extra Extra
+	for name, raw := range members {
+		if known[name] {
+			continue
+		}
+		if extra == nil {
+			extra = make(Extra)
+		}
+		extra[name] = raw
+	}
+	return extra, nil
+}
+
+// Marshal encodes v (a struct without JSON methods) followed by the extra members, in name order.
+func Marshal(v any, extra Extra) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil || len(extra) == 0 {
+		return data, err
+	}
+	names := make([]string, 0, len(extra))
+	for name := range extra {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var buf bytes.Buffer
+	buf.Write(data[:len(data)-1]) // drop the closing brace
+	for i, name := range names {
+		if i > 0 || len(data) > 2 {
+			buf.WriteByte(',')
+		}
+		key, _ := json.Marshal(name)
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(extra[name])
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: GPL-2.0-or-later
+/*
+ * Copyright (C) 2026 SCANOSS.COM
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 2 of the License, or
+ * (at your option) any later version.
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package jsonobject
+
+import (
+	"testing"
+)
+
+type testObject struct {
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
+	Ignored string `json:"-"`
+}
+
+func TestUnmarshalMarshal(t *testing.T) {
+	var obj testObject
+	extra, err := Unmarshal([]byte(`{"name":"a","version":"1","z":[1, 2],"b":{"c":true}}`), &obj)
+	if err != nil {
+		t.Fatalf("an error '%v' was not expected", err)
+	}
+	if obj.Name != "a" || obj.Version != "1" || len(extra) != 2 {
+		t.Errorf("Unmarshal() = %+v, %v", obj, extra)
+	}
+	data, err := Marshal(obj, extra)
+	if err != nil {
+		t.Fatalf("an error '%v' was not expected", err)
+	}
+	if want := `{"name":"a","version":"1","b":{"c":true},"z":[1, 2]}`; string(data) != want {
+		t.Errorf("Marshal() = %s, want %s", data, want)
+	}
+	data, err = Marshal(struct{}{}, Extra{"a": []byte(`1`)})
+	if err != nil || string(data) != `{"a":1}` {
+		t.Errorf("Marshal() = %s, %v, want {\"a\":1}", data, err)
+	}
+	if _, err = Unmarshal([]byte(`[]`), &obj); err == nil {
+		t.Errorf("Unmarshal() expected an error for a non-object")
+	}
+}
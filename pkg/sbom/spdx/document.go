@@ -0,0 +1,224 @@
+// SPDX-License-Identifier: GPL-2.0-or-later
+/*
+ * Copyright (C) 2026 SCANOSS.COM
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 2 of the License, or
+ * (at your option) any later version.
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+// Package spdx reads and writes SPDX documents, and enriches their packages with the versions,
+// licenses and project URLs held in a SCANOSS knowledge base.
+//
+// SPDX 2.3 documents are read from JSON and written as JSON or tag-value. Only the parts of the
+// specification needed for enrichment are modelled, and any other JSON members are preserved,
+// so a JSON document survives a Read/WriteJSON round trip.
+// SPDX 3.0 JSON-LD documents are handled separately by Read3, Write3 and Enricher.Enrich3.
+package spdx
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/scanoss/go-models/pkg/sbom/internal/jsonobject"
+)
+
+// NoAssertion is the SPDX value used when no information is available.
+const NoAssertion = "NOASSERTION"
+
+// ReferenceTypePurl is the external reference type of a Package URL.
+const ReferenceTypePurl = "purl"
+
+// Document represents an SPDX 2.x document.
+type Document struct {
+	SPDXVersion                string                 `json:"spdxVersion"`
+	DataLicense                string                 `json:"dataLicense"`
+	SPDXID                     string                 `json:"SPDXID"`
+	Name                       string                 `json:"name"`
+	DocumentNamespace          string                 `json:"documentNamespace"`
+	Comment                    string                 `json:"comment,omitempty"`
+	CreationInfo               *CreationInfo          `json:"creationInfo,omitempty"`
+	DocumentDescribes          []string               `json:"documentDescribes,omitempty"`
+	Packages                   []Package              `json:"packages,omitempty"`
+	Files                      []File                 `json:"files,omitempty"`
+	HasExtractedLicensingInfos []ExtractedLicenseInfo `json:"hasExtractedLicensingInfos,omitempty"`
+	Relationships              []Relationship         `json:"relationships,omitempty"`
+	extra                      jsonobject.Extra
+}
+
+// CreationInfo describes how and when a document was created.
+type CreationInfo struct {
+	Created            string   `json:"created"`
+	Creators           []string `json:"creators"`
+	LicenseListVersion string   `json:"licenseListVersion,omitempty"`
+	Comment            string   `json:"comment,omitempty"`
+	extra              jsonobject.Extra
+}
+
+// Package represents an SPDX package.
+type Package struct {
+	Name             string        `json:"name"`
+	SPDXID           string        `json:"SPDXID"`
+	VersionInfo      string        `json:"versionInfo,omitempty"`
+	Supplier         string        `json:"supplier,omitempty"`
+	Originator       string        `json:"originator,omitempty"`
+	DownloadLocation string        `json:"downloadLocation"`
+	FilesAnalyzed    *bool         `json:"filesAnalyzed,omitempty"`
+	Checksums        []Checksum    `json:"checksums,omitempty"`
+	Homepage         string        `json:"homepage,omitempty"`
+	LicenseConcluded string        `json:"licenseConcluded,omitempty"`
+	LicenseDeclared  string        `json:"licenseDeclared,omitempty"`
+	CopyrightText    string        `json:"copyrightText,omitempty"`
+	Description      string        `json:"description,omitempty"`
+	Comment          string        `json:"comment,omitempty"`
+	ExternalRefs     []ExternalRef `json:"externalRefs,omitempty"`
+	extra            jsonobject.Extra
+}
+
+// File represents an SPDX file.
+type File struct {
+	FileName           string     `json:"fileName"`
+	SPDXID             string     `json:"SPDXID"`
+	Checksums          []Checksum `json:"checksums,omitempty"`
+	LicenseConcluded   string     `json:"licenseConcluded,omitempty"`
+	LicenseInfoInFiles []string   `json:"licenseInfoInFiles,omitempty"`
+	CopyrightText      string     `json:"copyrightText,omitempty"`
+	extra              jsonobject.Extra
+}
+
+// Checksum represents a checksum of a package or file.
+type Checksum struct {
+	Algorithm     string `json:"algorithm"`
+	ChecksumValue string `json:"checksumValue"`
+}
+
+// ExternalRef represents a reference from a package to an external resource, such as a purl.
+type ExternalRef struct {
+	ReferenceCategory string `json:"referenceCategory"`
+	ReferenceType     string `json:"referenceType"`
+	ReferenceLocator  string `json:"referenceLocator"`
+	Comment           string `json:"comment,omitempty"`
+}
+
+// ExtractedLicenseInfo describes a license that is not on the SPDX license list.
+type ExtractedLicenseInfo struct {
+	LicenseID     string `json:"licenseId"`
+	ExtractedText string `json:"extractedText"`
+	Name          string `json:"name,omitempty"`
+	Comment       string `json:"comment,omitempty"`
+	extra         jsonobject.Extra
+}
+
+// Relationship describes a relationship between two SPDX elements.
+type Relationship struct {
+	SPDXElementID      string `json:"spdxElementId"`
+	RelationshipType   string `json:"relationshipType"`
+	RelatedSPDXElement string `json:"relatedSpdxElement"`
+	Comment            string `json:"comment,omitempty"`
+}
+
+// Read decodes an SPDX 2.x JSON document.
+func Read(r io.Reader) (*Document, error) {
+	var doc Document
+	if err := json.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("failed to decode SPDX document: %w", err)
+	}
+	if !strings.HasPrefix(doc.SPDXVersion, "SPDX-2.") {
+		return nil, fmt.Errorf("unsupported spdxVersion: %q", doc.SPDXVersion)
+	}
+	return &doc, nil
+}
+
+// WriteJSON encodes an SPDX 2.x document as indented JSON.
+func WriteJSON(w io.Writer, doc *Document) error {
+	if doc == nil {
+		return errors.New("no SPDX document to write")
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(doc); err != nil {
+		return fmt.Errorf("failed to encode SPDX document: %w", err)
+	}
+	return nil
+}
+
+// PurlRef returns the purl external reference of the package, or nil if it has none.
+func (p *Package) PurlRef() *ExternalRef {
+	for i := range p.ExternalRefs {
+		if p.ExternalRefs[i].ReferenceType == ReferenceTypePurl {
+			return &p.ExternalRefs[i]
+		}
+	}
+	return nil
+}
+
+func (d *Document) UnmarshalJSON(data []byte) error {
+	type alias Document
+	extra, err := jsonobject.Unmarshal(data, (*alias)(d))
+	d.extra = extra
+	return err
+}
+
+func (d Document) MarshalJSON() ([]byte, error) {
+	type alias Document
+	return jsonobject.Marshal(alias(d), d.extra)
+}
+
+func (c *CreationInfo) UnmarshalJSON(data []byte) error {
+	type alias CreationInfo
+	extra, err := jsonobject.Unmarshal(data, (*alias)(c))
+	c.extra = extra
+	return err
+}
+
+func (c CreationInfo) MarshalJSON() ([]byte, error) {
+	type alias CreationInfo
+	return jsonobject.Marshal(alias(c), c.extra)
+}
+
+func (p *Package) UnmarshalJSON(data []byte) error {
+	type alias Package
+	extra, err := jsonobject.Unmarshal(data, (*alias)(p))
+	p.extra = extra
+	return err
+}
+
+func (p Package) MarshalJSON() ([]byte, error) {
+	type alias Package
+	return jsonobject.Marshal(alias(p), p.extra)
+}
+
+func (f *File) UnmarshalJSON(data []byte) error {
+	type alias File
+	extra, err := jsonobject.Unmarshal(data, (*alias)(f))
+	f.extra = extra
+	return err
+}
+
+func (f File) MarshalJSON() ([]byte, error) {
+	type alias File
+	return jsonobject.Marshal(alias(f), f.extra)
+}
+
+func (e *ExtractedLicenseInfo) UnmarshalJSON(data []byte) error {
+	type alias ExtractedLicenseInfo
+	extra, err := jsonobject.Unmarshal(data, (*alias)(e))
+	e.extra = extra
+	return err
+}
+
+func (e ExtractedLicenseInfo) MarshalJSON() ([]byte, error) {
+	type alias ExtractedLicenseInfo
+	return jsonobject.Marshal(alias(e), e.extra)
+}
@@ -0,0 +1,104 @@
+// SPDX-License-Identifier: GPL-2.0-or-later
+/*
+ * Copyright (C) 2026 SCANOSS.COM
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 2 of the License, or
+ * (at your option) any later version.
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package spdx
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const testDocument = `{
+  "spdxVersion": "SPDX-2.3",
+  "dataLicense": "CC0-1.0",
+  "SPDXID": "SPDXRef-DOCUMENT",
+  "name": "demo",
+  "documentNamespace": "https://example.com/spdx/demo",
+  "creationInfo": {"created": "2026-01-01T00:00:00Z", "creators": ["Tool: demo"]},
+  "documentDescribes": ["SPDXRef-react"],
+  "packages": [
+    {
+      "name": "react",
+      "SPDXID": "SPDXRef-react",
+      "versionInfo": "^15.0.0",
+      "downloadLocation": "NOASSERTION",
+      "filesAnalyzed": false,
+      "licenseConcluded": "NOASSERTION",
+      "primaryPackagePurpose": "LIBRARY",
+      "externalRefs": [{"referenceCategory": "PACKAGE-MANAGER", "referenceType": "purl", "referenceLocator": "pkg:npm/react"}]
+    },
+    {
+      "name": "electron-updater",
+      "SPDXID": "SPDXRef-electron-updater",
+      "downloadLocation": "NOASSERTION",
+      "licenseDeclared": "Apache-2.0",
+      "externalRefs": [{"referenceCategory": "PACKAGE_MANAGER", "referenceType": "purl", "referenceLocator": "pkg:npm/electron-updater@4.0.8"}]
+    },
+    {"name": "unknown", "SPDXID": "SPDXRef-unknown", "downloadLocation": "NOASSERTION",
+      "externalRefs": [{"referenceCategory": "PACKAGE-MANAGER", "referenceType": "purl", "referenceLocator": "pkg:npm/does-not-exist-anywhere"}]},
+    {"name": "no-purl", "SPDXID": "SPDXRef-no-purl", "downloadLocation": "NOASSERTION"}
+  ],
+  "snippets": [{"SPDXID": "SPDXRef-snippet", "snippetFromFile": "SPDXRef-file"}],
+  "relationships": [{"spdxElementId": "SPDXRef-react", "relationshipType": "DEPENDS_ON", "relatedSpdxElement": "SPDXRef-electron-updater"}]
+}`
+
+func TestReadWriteJSON(t *testing.T) {
+	doc, err := Read(strings.NewReader(testDocument))
+	if err != nil {
+		t.Fatalf("an error '%v' was not expected when reading the document", err)
+	}
+	if len(doc.Packages) != 4 || doc.Packages[0].PurlRef().ReferenceLocator != "pkg:npm/react" || doc.Packages[3].PurlRef() != nil {
+		t.Errorf("Read() unexpected document: %#v", doc)
+	}
+	var buf bytes.Buffer
+	if err = WriteJSON(&buf, doc); err != nil {
+		t.Fatalf("an error '%v' was not expected when writing the document", err)
+	}
+	var want, got any
+	if err = json.Unmarshal([]byte(testDocument), &want); err != nil {
+		t.Fatalf("failed to decode test document: %v", err)
+	}
+	if err = json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("failed to decode written document: %v", err)
+	}
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("WriteJSON() did not round trip the document:\n%v", buf.String())
+	}
+}
+
+func TestReadInvalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{name: "not json", input: "SPDXVersion: SPDX-2.3"},
+		{name: "wrong version", input: `{"spdxVersion": "SPDX-3.0"}`},
+		{name: "cyclonedx", input: `{"bomFormat": "CycloneDX"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Read(strings.NewReader(tt.input)); err == nil {
+				t.Errorf("Read() expected an error for %v", tt.input)
+			}
+		})
+	}
+	if err := WriteJSON(&bytes.Buffer{}, nil); err == nil {
+		t.Errorf("WriteJSON() expected an error for a nil document")
+	}
+}
@@ -0,0 +1,193 @@
+// SPDX-License-Identifier: GPL-2.0-or-later
+/*
+ * Copyright (C) 2026 SCANOSS.COM
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 2 of the License, or
+ * (at your option) any later version.
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package spdx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
+	"github.com/scanoss/go-models/pkg/services"
+	"github.com/scanoss/go-models/pkg/types"
+	purlutils "github.com/scanoss/go-purl-helper/pkg"
+)
+
+// LicenseRefPrefix is the prefix of the license references created for licenses without a valid SPDX ID.
+const LicenseRefPrefix = "LicenseRef-scanoss-"
+
+var spdxIDRegex = regexp.MustCompile(`^[A-Za-z0-9.+-]+$`)   // regex to match a single SPDX license identifier
+var licenseRefRegex = regexp.MustCompile(`[^A-Za-z0-9.-]+`) // regex to match characters not allowed in a license reference
+
+// Enricher fills in missing package versions, licenses and project URLs using the component service.
+type Enricher struct {
+	component *services.ComponentService
+}
+
+// Summary reports the outcome of enriching a document.
+type Summary struct {
+	Packages int       // Number of packages with a purl
+	Enriched int       // Number of packages updated
+	Failures []Failure // Packages that could not be resolved
+}
+
+// Failure records a package that could not be resolved.
+type Failure struct {
+	SPDXID string
+	Purl   string
+	Err    error
+}
+
+// NewEnricher creates a new SPDX enricher using the given component service.
+func NewEnricher(component *services.ComponentService) *Enricher {
+	return &Enricher{component: component}
+}
+
+// Enrich resolves every package of an SPDX 2.x document that has a purl external reference.
+//
+// A purl without a version is resolved using the package versionInfo as the requirement, and both
+// are updated with the resolved version. licenseDeclared, licenseConcluded and homepage are only
+// set when missing (or NOASSERTION). Licenses without a valid SPDX ID are added as extracted licensing info.
+// Packages that cannot be resolved are reported in the summary rather than failing the whole document.
+func (e *Enricher) Enrich(ctx context.Context, doc *Document) (Summary, error) {
+	var summary Summary
+	if doc == nil {
+		return summary, errors.New("no SPDX document to enrich")
+	}
+	s := ctxzap.Extract(ctx).Sugar()
+	for i := range doc.Packages {
+		if err := ctx.Err(); err != nil {
+			return summary, err
+		}
+		p := &doc.Packages[i]
+		ref := p.PurlRef()
+		if ref == nil || len(ref.ReferenceLocator) == 0 {
+			continue
+		}
+		summary.Packages++
+		changed, err := e.enrichPackage(ctx, doc, p, ref)
+		switch {
+		case err != nil:
+			s.Debugf("Failed to enrich package %v: %v", ref.ReferenceLocator, err)
+			summary.Failures = append(summary.Failures, Failure{SPDXID: p.SPDXID, Purl: ref.ReferenceLocator, Err: err})
+		case changed:
+			summary.Enriched++
+		}
+	}
+	return summary, nil
+}
+
+// enrichPackage resolves a single package, reporting whether it was updated.
+func (e *Enricher) enrichPackage(ctx context.Context, doc *Document, p *Package, ref *ExternalRef) (bool, error) {
+	res, purl, err := e.resolve(ctx, ref.ReferenceLocator, p.VersionInfo)
+	if err != nil {
+		return false, err
+	}
+	var changed bool
+	switch {
+	case purl != ref.ReferenceLocator:
+		ref.ReferenceLocator = purl
+		p.VersionInfo = res.Version
+		changed = true
+	case len(p.VersionInfo) == 0:
+		p.VersionInfo = res.Version
+		changed = true
+	}
+	if len(res.Licenses) > 0 && (isUnset(p.LicenseDeclared) || isUnset(p.LicenseConcluded)) {
+		expression, extracted := licenseExpression(res.Licenses)
+		if isUnset(p.LicenseDeclared) {
+			p.LicenseDeclared = expression
+		}
+		if isUnset(p.LicenseConcluded) {
+			p.LicenseConcluded = expression
+		}
+		doc.addExtractedLicenses(extracted)
+		changed = true
+	}
+	if len(res.URL) > 0 && isUnset(p.Homepage) {
+		p.Homepage = res.URL
+		changed = true
+	}
+	return changed, nil
+}
+
+// resolve resolves a purl, using version as the requirement if the purl has no version.
+// It returns the resolved component and the purl including the resolved version.
+func (e *Enricher) resolve(ctx context.Context, purlString, version string) (types.ComponentResponse, string, error) {
+	purl, err := purlutils.PurlFromString(purlString)
+	if err != nil {
+		return types.ComponentResponse{}, "", fmt.Errorf("failed to parse purl: %w", err)
+	}
+	req := types.ComponentRequest{Purl: purlString}
+	if len(purl.Version) == 0 {
+		req.Requirement = version // may be an exact version or a range
+	}
+	res, err := e.component.GetComponent(ctx, req)
+	if err != nil {
+		return types.ComponentResponse{}, "", err
+	}
+	if len(purl.Version) == 0 {
+		purl.Version = res.Version
+		purlString = purl.ToString()
+	}
+	return res, purlString, nil
+}
+
+// addExtractedLicenses adds the given licenses to the document, unless already present.
+func (d *Document) addExtractedLicenses(licenses []ExtractedLicenseInfo) {
+	for _, l := range licenses {
+		found := false
+		for _, existing := range d.HasExtractedLicensingInfos {
+			if existing.LicenseID == l.LicenseID {
+				found = true
+				break
+			}
+		}
+		if !found {
+			d.HasExtractedLicensingInfos = append(d.HasExtractedLicensingInfos, l)
+		}
+	}
+}
+
+// licenseExpression converts knowledge base licenses to an SPDX license expression.
+// Licenses without a valid SPDX ID are referenced using a LicenseRef, which is returned as extracted licensing info.
+func licenseExpression(licenses []types.License) (string, []ExtractedLicenseInfo) {
+	ids := make([]string, 0, len(licenses))
+	var extracted []ExtractedLicenseInfo
+	for _, l := range licenses {
+		if l.IsSpdx && spdxIDRegex.MatchString(l.SPDXID) {
+			ids = append(ids, l.SPDXID)
+			continue
+		}
+		name := strings.Trim(licenseRefRegex.ReplaceAllString(l.Name, "-"), "-")
+		if len(name) == 0 {
+			name = strconv.Itoa(int(l.ID))
+		}
+		ref := LicenseRefPrefix + name
+		ids = append(ids, ref)
+		extracted = append(extracted, ExtractedLicenseInfo{LicenseID: ref, ExtractedText: l.Name, Name: l.Name})
+	}
+	return strings.Join(ids, " AND "), extracted
+}
+
+// isUnset reports whether an SPDX value is missing.
+func isUnset(value string) bool {
+	return len(value) == 0 || value == NoAssertion
+}
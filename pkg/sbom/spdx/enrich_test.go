@@ -0,0 +1,121 @@
+// SPDX-License-Identifier: GPL-2.0-or-later
+/*
+ * Copyright (C) 2026 SCANOSS.COM
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 2 of the License, or
+ * (at your option) any later version.
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package spdx
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/scanoss/go-models/internal/testutils"
+	"github.com/scanoss/go-models/pkg/models"
+	"github.com/scanoss/go-models/pkg/services"
+	"github.com/scanoss/go-models/pkg/types"
+	zlog "github.com/scanoss/zap-logging-helper/pkg/logger"
+)
+
+func TestEnrich(t *testing.T) {
+	err := zlog.NewSugaredDevLogger()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a sugared logger", err)
+	}
+	defer zlog.SyncZap()
+	ctx := context.Background()
+	db := testutils.SqliteSetup(t) // Setup SQL Lite DB
+	defer testutils.CloseDB(t, db)
+	testutils.LoadMockSQLData(t, db, "../../../internal/testutils/mock")
+	enricher := NewEnricher(services.NewComponentService(models.NewModels(db)))
+
+	doc, err := Read(strings.NewReader(testDocument))
+	if err != nil {
+		t.Fatalf("an error '%v' was not expected when reading the document", err)
+	}
+	summary, err := enricher.Enrich(ctx, doc)
+	if err != nil {
+		t.Fatalf("an error '%v' was not expected when enriching the document", err)
+	}
+	if summary.Packages != 3 || summary.Enriched != 2 || len(summary.Failures) != 1 {
+		t.Errorf("Enrich() summary = %+v, want 3 packages, 2 enriched and 1 failure", summary)
+	}
+	if len(summary.Failures) == 1 && summary.Failures[0].SPDXID != "SPDXRef-unknown" {
+		t.Errorf("Enrich() unexpected failure: %+v", summary.Failures[0])
+	}
+
+	react := doc.Packages[0]
+	if react.VersionInfo != "15.7.0" || react.PurlRef().ReferenceLocator != "pkg:npm/react@15.7.0" {
+		t.Errorf("Enrich() react version = %v, purl = %v, want 15.7.0", react.VersionInfo, react.PurlRef().ReferenceLocator)
+	}
+	if react.LicenseDeclared != "MIT" || react.LicenseConcluded != "MIT" || react.Homepage != "https://www.npmjs.com/package/react" {
+		t.Errorf("Enrich() react declared = %v, concluded = %v, homepage = %v", react.LicenseDeclared, react.LicenseConcluded, react.Homepage)
+	}
+	updater := doc.Packages[1]
+	if updater.VersionInfo != "4.0.8" || updater.PurlRef().ReferenceLocator != "pkg:npm/electron-updater@4.0.8" {
+		t.Errorf("Enrich() electron-updater version = %v, purl = %v, want 4.0.8", updater.VersionInfo, updater.PurlRef().ReferenceLocator)
+	}
+	if updater.LicenseDeclared != "Apache-2.0" || updater.LicenseConcluded != "MIT" {
+		t.Errorf("Enrich() should not replace existing licenses: declared = %v, concluded = %v", updater.LicenseDeclared, updater.LicenseConcluded)
+	}
+	if len(doc.HasExtractedLicensingInfos) != 0 {
+		t.Errorf("Enrich() unexpected extracted licenses: %+v", doc.HasExtractedLicensingInfos)
+	}
+
+	summary, err = enricher.Enrich(ctx, doc)
+	if err != nil || summary.Enriched != 0 {
+		t.Errorf("Enrich() should be idempotent: %+v, %v", summary, err)
+	}
+	if _, err = enricher.Enrich(ctx, nil); err == nil {
+		t.Errorf("Enrich() expected an error for a nil document")
+	}
+}
+
+func TestLicenseExpression(t *testing.T) {
+	tests := []struct {
+		name          string
+		licenses      []types.License
+		want          string
+		wantExtracted int
+	}{
+		{name: "spdx", licenses: []types.License{{Name: "MIT", SPDXID: "MIT", IsSpdx: true}}, want: "MIT"},
+		{
+			name:          "invalid spdx id",
+			licenses:      []types.License{{Name: "GPL-2 or GPL-3", SPDXID: "GPL-2.0-only/GPL-3.0-only", IsSpdx: true}},
+			want:          "LicenseRef-scanoss-GPL-2-or-GPL-3",
+			wantExtracted: 1,
+		},
+		{
+			name:          "multiple",
+			licenses:      []types.License{{Name: "MIT", SPDXID: "MIT", IsSpdx: true}, {ID: 42, Name: "©", IsSpdx: false}},
+			want:          "MIT AND LicenseRef-scanoss-42",
+			wantExtracted: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, extracted := licenseExpression(tt.licenses)
+			if got != tt.want || len(extracted) != tt.wantExtracted {
+				t.Errorf("licenseExpression() = %v, %+v, want %v with %v extracted", got, extracted, tt.want, tt.wantExtracted)
+			}
+		})
+	}
+	doc := &Document{}
+	_, extracted := licenseExpression([]types.License{{Name: "Custom"}})
+	doc.addExtractedLicenses(extracted)
+	doc.addExtractedLicenses(extracted)
+	if len(doc.HasExtractedLicensingInfos) != 1 || doc.HasExtractedLicensingInfos[0].ExtractedText != "Custom" {
+		t.Errorf("addExtractedLicenses() = %+v, want a single Custom license", doc.HasExtractedLicensingInfos)
+	}
+}
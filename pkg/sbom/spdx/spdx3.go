@@ -0,0 +1,206 @@
+// SPDX-License-Identifier: GPL-2.0-or-later
+/*
+ * Copyright (C) 2026 SCANOSS.COM
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 2 of the License, or
+ * (at your option) any later version.
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package spdx
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
+	"github.com/scanoss/go-models/pkg/sbom/internal/jsonobject"
+)
+
+// SPDX 3.0 element types and relationship types used during enrichment.
+const (
+	TypePackage                  = "software_Package"
+	TypeRelationship             = "Relationship"
+	TypeLicenseExpression        = "simplelicensing_LicenseExpression"
+	RelationshipDeclaredLicense  = "hasDeclaredLicense"
+	RelationshipConcludedLicense = "hasConcludedLicense"
+)
+
+// Element is a generic SPDX 3.0 element, keyed by JSON-LD property name.
+type Element map[string]json.RawMessage
+
+// Document3 represents an SPDX 3.0 JSON-LD document.
+// Elements are kept as raw JSON, as the graph holds many element types not modelled here.
+type Document3 struct {
+	Context json.RawMessage `json:"@context"`
+	Graph   []Element       `json:"@graph"`
+	extra   jsonobject.Extra
+}
+
+// Read3 decodes an SPDX 3.0 JSON-LD document.
+func Read3(r io.Reader) (*Document3, error) {
+	var doc Document3
+	if err := json.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("failed to decode SPDX 3 document: %w", err)
+	}
+	if !bytes.Contains(doc.Context, []byte("spdx.org/rdf/3.")) {
+		return nil, fmt.Errorf("unsupported @context: %s", doc.Context)
+	}
+	return &doc, nil
+}
+
+// Write3 encodes an SPDX 3.0 JSON-LD document as indented JSON.
+func Write3(w io.Writer, doc *Document3) error {
+	if doc == nil {
+		return errors.New("no SPDX document to write")
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(doc); err != nil {
+		return fmt.Errorf("failed to encode SPDX 3 document: %w", err)
+	}
+	return nil
+}
+
+// String returns the string value of the given property, or an empty string if it is not a string.
+func (el Element) String(name string) string {
+	var value string
+	if raw, ok := el[name]; ok {
+		_ = json.Unmarshal(raw, &value)
+	}
+	return value
+}
+
+// SetString sets the given property to a string value.
+func (el Element) SetString(name, value string) {
+	raw, _ := json.Marshal(value)
+	el[name] = raw
+}
+
+// Enrich3 resolves every software_Package of an SPDX 3.0 document that has a software_packageUrl.
+//
+// The purl and software_packageVersion are updated as for Enrich, and software_homePage is set if missing.
+// Packages without declared or concluded license relationships get a license expression element and
+// the matching relationships, reusing the creationInfo of the package.
+func (e *Enricher) Enrich3(ctx context.Context, doc *Document3) (Summary, error) {
+	var summary Summary
+	if doc == nil {
+		return summary, errors.New("no SPDX document to enrich")
+	}
+	s := ctxzap.Extract(ctx).Sugar()
+	licensed := make(map[string]bool) // package ID + relationship type
+	for _, el := range doc.Graph {
+		if el.String("type") == TypeRelationship {
+			licensed[el.String("from")+" "+el.String("relationshipType")] = true
+		}
+	}
+	packages := len(doc.Graph) // elements added during enrichment are not packages
+	for i := range packages {
+		if err := ctx.Err(); err != nil {
+			return summary, err
+		}
+		el := doc.Graph[i]
+		purl := el.String("software_packageUrl")
+		if el.String("type") != TypePackage || len(purl) == 0 {
+			continue
+		}
+		summary.Packages++
+		changed, err := e.enrichElement(ctx, doc, el, licensed)
+		switch {
+		case err != nil:
+			s.Debugf("Failed to enrich package %v: %v", purl, err)
+			summary.Failures = append(summary.Failures, Failure{SPDXID: el.String("spdxId"), Purl: purl, Err: err})
+		case changed:
+			summary.Enriched++
+		}
+	}
+	return summary, nil
+}
+
+// enrichElement resolves a single software_Package element, reporting whether the document was updated.
+func (e *Enricher) enrichElement(ctx context.Context, doc *Document3, el Element, licensed map[string]bool) (bool, error) {
+	purl := el.String("software_packageUrl")
+	res, resolved, err := e.resolve(ctx, purl, el.String("software_packageVersion"))
+	if err != nil {
+		return false, err
+	}
+	var changed bool
+	if resolved != purl || len(el.String("software_packageVersion")) == 0 {
+		el.SetString("software_packageUrl", resolved)
+		el.SetString("software_packageVersion", res.Version)
+		changed = true
+	}
+	if len(res.URL) > 0 && len(el.String("software_homePage")) == 0 {
+		el.SetString("software_homePage", res.URL)
+		changed = true
+	}
+	if len(res.Licenses) == 0 {
+		return changed, nil
+	}
+	id := el.String("spdxId")
+	expression, _ := licenseExpression(res.Licenses)
+	licenseID := id + "-scanoss-license"
+	added := false
+	for _, relType := range []string{RelationshipDeclaredLicense, RelationshipConcludedLicense} {
+		if licensed[id+" "+relType] {
+			continue
+		}
+		if !added {
+			license := newElement(el)
+			license.SetString("type", TypeLicenseExpression)
+			license.SetString("spdxId", licenseID)
+			license.SetString("simplelicensing_licenseExpression", expression)
+			doc.Graph = append(doc.Graph, license)
+			added = true
+		}
+		rel := newElement(el)
+		rel["to"] = mustMarshal([]string{licenseID})
+		rel.SetString("type", TypeRelationship)
+		rel.SetString("spdxId", id+"-scanoss-"+relType)
+		rel.SetString("from", id)
+		rel.SetString("relationshipType", relType)
+		doc.Graph = append(doc.Graph, rel)
+		licensed[id+" "+relType] = true
+		changed = true
+	}
+	return changed, nil
+}
+
+// newElement creates an element sharing the creationInfo of the given element.
+func newElement(from Element) Element {
+	el := make(Element)
+	if creationInfo, ok := from["creationInfo"]; ok {
+		el["creationInfo"] = creationInfo
+	}
+	return el
+}
+
+// mustMarshal encodes a value that cannot fail to encode.
+func mustMarshal(v any) json.RawMessage {
+	raw, _ := json.Marshal(v)
+	return raw
+}
+
+func (d *Document3) UnmarshalJSON(data []byte) error {
+	type alias Document3
+	extra, err := jsonobject.Unmarshal(data, (*alias)(d))
+	d.extra = extra
+	return err
+}
+
+func (d Document3) MarshalJSON() ([]byte, error) {
+	type alias Document3
+	return jsonobject.Marshal(alias(d), d.extra)
+}
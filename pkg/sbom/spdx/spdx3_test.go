@@ -0,0 +1,117 @@
+// SPDX-License-Identifier: GPL-2.0-or-later
+/*
+ * Copyright (C) 2026 SCANOSS.COM
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 2 of the License, or
+ * (at your option) any later version.
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package spdx
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/scanoss/go-models/internal/testutils"
+	"github.com/scanoss/go-models/pkg/models"
+	"github.com/scanoss/go-models/pkg/services"
+	zlog "github.com/scanoss/zap-logging-helper/pkg/logger"
+)
+
+const testDocument3 = `{
+  "@context": "https://spdx.org/rdf/3.0.1/spdx-context.jsonld",
+  "@graph": [
+    {"type": "CreationInfo", "@id": "_:creationinfo", "created": "2026-01-01T00:00:00Z", "createdBy": ["https://example.com/demo"], "specVersion": "3.0.1"},
+    {"type": "software_Package", "spdxId": "https://example.com/react", "creationInfo": "_:creationinfo", "name": "react",
+      "software_packageVersion": "^15.0.0", "software_packageUrl": "pkg:npm/react"},
+    {"type": "software_Package", "spdxId": "https://example.com/electron-updater", "creationInfo": "_:creationinfo", "name": "electron-updater",
+      "software_packageUrl": "pkg:npm/electron-updater@4.0.8", "software_homePage": "https://www.electron.build"},
+    {"type": "simplelicensing_LicenseExpression", "spdxId": "https://example.com/apache", "creationInfo": "_:creationinfo",
+      "simplelicensing_licenseExpression": "Apache-2.0"},
+    {"type": "Relationship", "spdxId": "https://example.com/rel", "creationInfo": "_:creationinfo", "from": "https://example.com/electron-updater",
+      "relationshipType": "hasDeclaredLicense", "to": ["https://example.com/apache"]},
+    {"type": "software_Package", "spdxId": "https://example.com/unknown", "creationInfo": "_:creationinfo", "name": "unknown",
+      "software_packageUrl": "pkg:npm/does-not-exist-anywhere"}
+  ]
+}`
+
+func TestEnrich3(t *testing.T) {
+	err := zlog.NewSugaredDevLogger()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a sugared logger", err)
+	}
+	defer zlog.SyncZap()
+	ctx := context.Background()
+	db := testutils.SqliteSetup(t) // Setup SQL Lite DB
+	defer testutils.CloseDB(t, db)
+	testutils.LoadMockSQLData(t, db, "../../../internal/testutils/mock")
+	enricher := NewEnricher(services.NewComponentService(models.NewModels(db)))
+
+	if _, err = Read3(strings.NewReader(testDocument)); err == nil {
+		t.Errorf("Read3() expected an error for an SPDX 2.3 document")
+	}
+	doc, err := Read3(strings.NewReader(testDocument3))
+	if err != nil {
+		t.Fatalf("an error '%v' was not expected when reading the document", err)
+	}
+	summary, err := enricher.Enrich3(ctx, doc)
+	if err != nil {
+		t.Fatalf("an error '%v' was not expected when enriching the document", err)
+	}
+	if summary.Packages != 3 || summary.Enriched != 2 || len(summary.Failures) != 1 {
+		t.Errorf("Enrich3() summary = %+v, want 3 packages, 2 enriched and 1 failure", summary)
+	}
+	react := doc.Graph[1]
+	if react.String("software_packageVersion") != "15.7.0" || react.String("software_packageUrl") != "pkg:npm/react@15.7.0" {
+		t.Errorf("Enrich3() react = %v", react)
+	}
+	if react.String("software_homePage") != "https://www.npmjs.com/package/react" {
+		t.Errorf("Enrich3() react homepage = %v", react.String("software_homePage"))
+	}
+	if doc.Graph[2].String("software_homePage") != "https://www.electron.build" {
+		t.Errorf("Enrich3() should not replace the existing homepage: %v", doc.Graph[2])
+	}
+	// react gets a license element and two relationships, electron-updater an element and a concluded relationship
+	if len(doc.Graph) != 6+3+2 {
+		t.Fatalf("Enrich3() graph has %v elements, want 11", len(doc.Graph))
+	}
+	license := doc.Graph[6]
+	if license.String("type") != TypeLicenseExpression || license.String("simplelicensing_licenseExpression") != "MIT" ||
+		license.String("creationInfo") != "_:creationinfo" {
+		t.Errorf("Enrich3() unexpected license element: %v", license)
+	}
+	var concluded int
+	for _, el := range doc.Graph[6:] {
+		if el.String("relationshipType") == RelationshipConcludedLicense {
+			concluded++
+		}
+		if el.String("from") == "https://example.com/electron-updater" && el.String("relationshipType") == RelationshipDeclaredLicense {
+			t.Errorf("Enrich3() should not add a declared license to electron-updater: %v", el)
+		}
+	}
+	if concluded != 2 {
+		t.Errorf("Enrich3() added %v concluded license relationships, want 2", concluded)
+	}
+
+	summary, err = enricher.Enrich3(ctx, doc)
+	if err != nil || summary.Enriched != 0 || len(doc.Graph) != 11 {
+		t.Errorf("Enrich3() should be idempotent: %+v, %v", summary, err)
+	}
+	var buf bytes.Buffer
+	if err = Write3(&buf, doc); err != nil {
+		t.Fatalf("an error '%v' was not expected when writing the document", err)
+	}
+	if _, err = Read3(&buf); err != nil {
+		t.Errorf("Read3() failed to read the written document: %v", err)
+	}
+}
@@ -0,0 +1,151 @@
+// SPDX-License-Identifier: GPL-2.0-or-later
+/*
+ * Copyright (C) 2026 SCANOSS.COM
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 2 of the License, or
+ * (at your option) any later version.
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package spdx
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// tagWriter writes SPDX tag-value lines, skipping empty values.
+type tagWriter struct {
+	w *bufio.Writer
+}
+
+// tag writes a single-line tag.
+func (tw *tagWriter) tag(tag, value string) {
+	if len(value) == 0 {
+		return
+	}
+	if strings.Contains(value, "\n") {
+		tw.text(tag, value)
+		return
+	}
+	_, _ = fmt.Fprintf(tw.w, "%s: %s\n", tag, value)
+}
+
+// text writes a free-form text tag, which may span multiple lines.
+func (tw *tagWriter) text(tag, value string) {
+	if len(value) == 0 {
+		return
+	}
+	_, _ = fmt.Fprintf(tw.w, "%s: <text>%s</text>\n", tag, value)
+}
+
+// section starts a new section with the given comment.
+func (tw *tagWriter) section(comment string) {
+	_, _ = fmt.Fprintf(tw.w, "\n## %s\n", comment)
+}
+
+// WriteTagValue encodes an SPDX 2.x document in the tag-value format.
+// Only the modelled fields are written, as the JSON members preserved by Read have no tag-value representation.
+func WriteTagValue(w io.Writer, doc *Document) error {
+	if doc == nil {
+		return errors.New("no SPDX document to write")
+	}
+	tw := &tagWriter{w: bufio.NewWriter(w)}
+	tw.tag("SPDXVersion", doc.SPDXVersion)
+	tw.tag("DataLicense", doc.DataLicense)
+	tw.tag("SPDXID", doc.SPDXID)
+	tw.tag("DocumentName", doc.Name)
+	tw.tag("DocumentNamespace", doc.DocumentNamespace)
+	tw.text("DocumentComment", doc.Comment)
+	if ci := doc.CreationInfo; ci != nil {
+		for _, creator := range ci.Creators {
+			tw.tag("Creator", creator)
+		}
+		tw.tag("Created", ci.Created)
+		tw.tag("LicenseListVersion", ci.LicenseListVersion)
+		tw.text("CreatorComment", ci.Comment)
+	}
+	for _, id := range doc.DocumentDescribes {
+		tw.tag("Relationship", doc.SPDXID+" DESCRIBES "+id)
+	}
+	for i := range doc.Packages {
+		writePackage(tw, &doc.Packages[i])
+	}
+	for i := range doc.Files {
+		writeFile(tw, &doc.Files[i])
+	}
+	if len(doc.HasExtractedLicensingInfos) > 0 {
+		tw.section("Other Licensing Information")
+		for _, l := range doc.HasExtractedLicensingInfos {
+			tw.tag("LicenseID", l.LicenseID)
+			tw.text("ExtractedText", l.ExtractedText)
+			tw.tag("LicenseName", l.Name)
+			tw.text("LicenseComment", l.Comment)
+		}
+	}
+	if len(doc.Relationships) > 0 {
+		tw.section("Relationships")
+		for _, r := range doc.Relationships {
+			tw.tag("Relationship", r.SPDXElementID+" "+r.RelationshipType+" "+r.RelatedSPDXElement)
+			tw.text("RelationshipComment", r.Comment)
+		}
+	}
+	if err := tw.w.Flush(); err != nil {
+		return fmt.Errorf("failed to write SPDX tag-value document: %w", err)
+	}
+	return nil
+}
+
+// writePackage writes the tags of a package.
+func writePackage(tw *tagWriter, p *Package) {
+	tw.section("Package: " + p.Name)
+	tw.tag("PackageName", p.Name)
+	tw.tag("SPDXID", p.SPDXID)
+	tw.tag("PackageVersion", p.VersionInfo)
+	tw.tag("PackageSupplier", p.Supplier)
+	tw.tag("PackageOriginator", p.Originator)
+	tw.tag("PackageDownloadLocation", p.DownloadLocation)
+	if p.FilesAnalyzed != nil {
+		tw.tag("FilesAnalyzed", strconv.FormatBool(*p.FilesAnalyzed))
+	}
+	for _, c := range p.Checksums {
+		tw.tag("PackageChecksum", c.Algorithm+": "+c.ChecksumValue)
+	}
+	tw.tag("PackageHomePage", p.Homepage)
+	tw.tag("PackageLicenseConcluded", p.LicenseConcluded)
+	tw.tag("PackageLicenseDeclared", p.LicenseDeclared)
+	tw.tag("PackageCopyrightText", p.CopyrightText)
+	tw.text("PackageDescription", p.Description)
+	tw.text("PackageComment", p.Comment)
+	for _, r := range p.ExternalRefs {
+		// Tag-value only uses the hyphenated form of the reference categories (e.g. PACKAGE-MANAGER)
+		tw.tag("ExternalRef", strings.ReplaceAll(r.ReferenceCategory, "_", "-")+" "+r.ReferenceType+" "+r.ReferenceLocator)
+		tw.text("ExternalRefComment", r.Comment)
+	}
+}
+
+// writeFile writes the tags of a file.
+func writeFile(tw *tagWriter, f *File) {
+	tw.section("File: " + f.FileName)
+	tw.tag("FileName", f.FileName)
+	tw.tag("SPDXID", f.SPDXID)
+	for _, c := range f.Checksums {
+		tw.tag("FileChecksum", c.Algorithm+": "+c.ChecksumValue)
+	}
+	tw.tag("LicenseConcluded", f.LicenseConcluded)
+	for _, l := range f.LicenseInfoInFiles {
+		tw.tag("LicenseInfoInFile", l)
+	}
+	tw.tag("FileCopyrightText", f.CopyrightText)
+}
@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: GPL-2.0-or-later
+/*
+ * Copyright (C) 2026 SCANOSS.COM
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 2 of the License, or
+ * (at your option) any later version.
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package spdx
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestWriteTagValue(t *testing.T) {
+	doc, err := Read(strings.NewReader(testDocument))
+	if err != nil {
+		t.Fatalf("an error '%v' was not expected when reading the document", err)
+	}
+	doc.Packages[0].Comment = "first line\nsecond line"
+	doc.HasExtractedLicensingInfos = []ExtractedLicenseInfo{{LicenseID: "LicenseRef-scanoss-Custom", ExtractedText: "Custom", Name: "Custom"}}
+	var buf bytes.Buffer
+	if err = WriteTagValue(&buf, doc); err != nil {
+		t.Fatalf("an error '%v' was not expected when writing the document", err)
+	}
+	out := buf.String()
+	for _, want := range []string{
+		"SPDXVersion: SPDX-2.3\n",
+		"DocumentName: demo\n",
+		"Creator: Tool: demo\n",
+		"Relationship: SPDXRef-DOCUMENT DESCRIBES SPDXRef-react\n",
+		"PackageName: react\n",
+		"PackageVersion: ^15.0.0\n",
+		"FilesAnalyzed: false\n",
+		"PackageLicenseConcluded: NOASSERTION\n",
+		"PackageComment: <text>first line\nsecond line</text>\n",
+		"ExternalRef: PACKAGE-MANAGER purl pkg:npm/electron-updater@4.0.8\n",
+		"LicenseID: LicenseRef-scanoss-Custom\nExtractedText: <text>Custom</text>\nLicenseName: Custom\n",
+		"Relationship: SPDXRef-react DEPENDS_ON SPDXRef-electron-updater\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("WriteTagValue() output missing %q:\n%v", want, out)
+		}
+	}
+	if strings.Contains(out, "PackageVersion: \n") || strings.Contains(out, "snippet") {
+		t.Errorf("WriteTagValue() unexpected output:\n%v", out)
+	}
+	if err = WriteTagValue(&buf, nil); err == nil {
+		t.Errorf("WriteTagValue() expected an error for a nil document")
+	}
+}
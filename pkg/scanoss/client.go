@@ -17,6 +17,8 @@
 package scanoss
 
 import (
+	"context"
+
 	"github.com/jmoiron/sqlx"
 	"github.com/scanoss/go-models/pkg/models"
 	"github.com/scanoss/go-models/pkg/services"
@@ -26,11 +28,19 @@ import (
 type Client struct {
 	Models    *models.Models
 	Component *services.ComponentService
+	Report    *services.ReportService
 }
 
 // New creates a SCANOSS Model Client.
+// Schema compatibility problems, including a failed check, are recorded in Models.Schema. Use NewStrict to fail fast.
 func New(db *sqlx.DB) *Client {
-	m := models.NewModels(db)
+	return NewContext(context.Background(), db)
+}
+
+// NewContext creates a SCANOSS Model Client, checking the database schema with the given context.
+// Schema compatibility problems, including a failed check, are recorded in Models.Schema. Use NewStrict to fail fast.
+func NewContext(ctx context.Context, db *sqlx.DB) *Client {
+	m := models.NewModelsContext(ctx, db)
 
 	// Initialize services
 	component := services.NewComponentService(m)
@@ -38,5 +48,20 @@ func New(db *sqlx.DB) *Client {
 	return &Client{
 		Models:    m,
 		Component: component,
+		Report:    services.NewReportService(component),
+	}
+}
+
+// NewStrict creates a SCANOSS Model Client, failing fast if the database schema version is not supported.
+func NewStrict(ctx context.Context, db *sqlx.DB) (*Client, error) {
+	m, err := models.NewModelsStrict(ctx, db)
+	if err != nil {
+		return nil, err
 	}
+	component := services.NewComponentService(m)
+	return &Client{
+		Models:    m,
+		Component: component,
+		Report:    services.NewReportService(component),
+	}, nil
 }
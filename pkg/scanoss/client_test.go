@@ -18,10 +18,12 @@ package scanoss
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
 	"github.com/scanoss/go-models/internal/testutils"
+	"github.com/scanoss/go-models/pkg/models"
 	zlog "github.com/scanoss/zap-logging-helper/pkg/logger"
 )
 
@@ -48,3 +50,32 @@ func TestNew(t *testing.T) {
 		t.Error("New did not initialize Component service")
 	}
 }
+
+func TestNewStrict(t *testing.T) {
+	err := zlog.NewSugaredDevLogger()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a sugared logger", err)
+	}
+	defer zlog.SyncZap()
+	ctx := ctxzap.ToContext(context.Background(), zlog.L)
+	db := testutils.SqliteSetup(t) // Setup SQL Lite DB
+	defer testutils.CloseDB(t, db)
+	testutils.LoadMockSQLData(t, db, "../../internal/testutils/mock")
+	testutils.LoadSQLDataFile(t, db, "../../internal/testutils/mock/db_version.sql")
+
+	client, err := NewStrict(ctx, db)
+	if err != nil {
+		t.Fatalf("NewStrict() error = %v", err)
+	}
+	if client.Models == nil || client.Component == nil {
+		t.Error("NewStrict did not initialize the client")
+	}
+
+	if _, err = db.Exec("UPDATE db_version SET schema_version = '3.0.0'"); err != nil {
+		t.Fatalf("failed to update db_version table: %v", err)
+	}
+	_, err = NewStrict(ctx, db)
+	if !errors.Is(err, models.ErrSchemaIncompatible) {
+		t.Errorf("NewStrict() expected ErrSchemaIncompatible, got %v", err)
+	}
+}
@@ -0,0 +1,166 @@
+// SPDX-License-Identifier: GPL-2.0-or-later
+/*
+ * Copyright (C) 2026 SCANOSS.COM
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 2 of the License, or
+ * (at your option) any later version.
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
+	"github.com/scanoss/go-models/pkg/helpers"
+	"github.com/scanoss/go-models/pkg/models"
+	"github.com/scanoss/go-models/pkg/types"
+)
+
+// GetComponentByHash identifies the component version of an artifact from its MD5 hash.
+// The hash is matched against package archive hashes first, then download URL hashes.
+// Returns models.ErrNotFound if the hash is not known.
+func (cs *ComponentService) GetComponentByHash(ctx context.Context, hash string) (types.ArtifactResponse, error) {
+	if len(strings.TrimSpace(hash)) == 0 {
+		return types.ArtifactResponse{}, fmt.Errorf("%w: please specify a valid hash to query", models.ErrInvalidInput)
+	}
+	res, err := cs.GetComponentsByHash(ctx, []string{hash})
+	if err != nil {
+		return types.ArtifactResponse{}, err
+	}
+	if len(res) == 0 {
+		return types.ArtifactResponse{}, fmt.Errorf("%w: no component found for hash %s", models.ErrNotFound, hash)
+	}
+	return res[0], nil
+}
+
+// GetComponentsByHash identifies the component versions of a batch of artifacts from their MD5 hashes.
+// Results are returned in request order, omitting any unknown hashes.
+func (cs *ComponentService) GetComponentsByHash(ctx context.Context, hashes []string) ([]types.ArtifactResponse, error) {
+	if err := cs.models.Schema.Check(models.ModelAllUrls); err != nil {
+		return nil, err
+	}
+	s := ctxzap.Extract(ctx).Sugar()
+	byPackage, err := cs.models.AllUrls.GetURLsByPackageHashes(ctx, hashes)
+	if err != nil {
+		return nil, err
+	}
+	matches := make(map[string]types.ArtifactResponse, len(hashes))
+	bestArtifacts(byPackage, func(u models.AllURL) string { return u.PackageHash }, types.HashTypePackage, matches)
+
+	var remaining []string
+	for _, hash := range hashes {
+		if _, ok := matches[normaliseHash(hash)]; !ok {
+			remaining = append(remaining, hash)
+		}
+	}
+	if len(remaining) > 0 {
+		byURL, urlErr := cs.models.AllUrls.GetURLsByURLHashes(ctx, remaining)
+		if urlErr != nil {
+			return nil, urlErr
+		}
+		bestArtifacts(byURL, func(u models.AllURL) string { return u.URLHash }, types.HashTypeURL, matches)
+	}
+
+	res := make([]types.ArtifactResponse, 0, len(matches))
+	seen := make(map[string]bool, len(matches))
+	for _, hash := range hashes {
+		hash = normaliseHash(hash)
+		if match, ok := matches[hash]; ok && !seen[hash] {
+			seen[hash] = true
+			res = append(res, match)
+		}
+	}
+	s.Debugf("Identified %v of %v hashes", len(res), len(hashes))
+	return res, nil
+}
+
+// GetComponentByURL identifies the component version of an artifact from its download URL.
+// The URL is matched exactly first, then by its normalised variants (scheme, host aliases and trailing slash).
+// Returns models.ErrNotFound if the URL is not known.
+func (cs *ComponentService) GetComponentByURL(ctx context.Context, downloadURL string) (types.ArtifactResponse, error) {
+	if err := cs.models.Schema.Check(models.ModelAllUrls); err != nil {
+		return types.ArtifactResponse{}, err
+	}
+	variants, err := helpers.DownloadURLVariants(downloadURL)
+	if err != nil {
+		return types.ArtifactResponse{}, fmt.Errorf("%w: invalid download URL %q: %w", models.ErrInvalidInput, downloadURL, err)
+	}
+	allUrls, err := cs.models.AllUrls.GetURLsByDownloadURL(ctx, variants[0])
+	if err != nil {
+		return types.ArtifactResponse{}, err
+	}
+	if len(allUrls) == 0 {
+		ctxzap.Extract(ctx).Sugar().Debugf("No exact match for %v, trying %v variants", variants[0], len(variants)-1)
+		if allUrls, err = cs.models.AllUrls.GetURLsByDownloadURLs(ctx, variants[1:]); err != nil {
+			return types.ArtifactResponse{}, err
+		}
+	}
+	if len(allUrls) == 0 {
+		return types.ArtifactResponse{}, fmt.Errorf("%w: no component found for download URL %s", models.ErrNotFound, downloadURL)
+	}
+	best := allUrls[0]
+	for _, u := range allUrls[1:] {
+		if preferArtifact(u, best) {
+			best = u
+		}
+	}
+	return artifactResponse(best), nil
+}
+
+// bestArtifacts adds the preferred artifact for each hash (as returned by hashOf) to matches.
+func bestArtifacts(allUrls []models.AllURL, hashOf func(models.AllURL) string, hashType string, matches map[string]types.ArtifactResponse) {
+	best := make(map[string]models.AllURL, len(allUrls))
+	for _, u := range allUrls {
+		hash := normaliseHash(hashOf(u))
+		if current, ok := best[hash]; !ok || preferArtifact(u, current) {
+			best[hash] = u
+		}
+	}
+	for hash, u := range best {
+		res := artifactResponse(u)
+		res.Hash, res.HashType = hash, hashType
+		matches[hash] = res
+	}
+}
+
+// preferArtifact reports whether a is a better match for an artifact than b.
+// Versioned rows are preferred, then the earliest release (the original publication), then the purl name.
+func preferArtifact(a, b models.AllURL) bool {
+	if a.HasVersion != b.HasVersion {
+		return a.HasVersion
+	}
+	if a.Date != b.Date {
+		return len(b.Date) == 0 || (len(a.Date) > 0 && a.Date < b.Date)
+	}
+	if a.PurlType != b.PurlType {
+		return a.PurlType < b.PurlType
+	}
+	return a.PurlName < b.PurlName
+}
+
+// artifactResponse converts an all_urls row into an artifact response.
+func artifactResponse(u models.AllURL) types.ArtifactResponse {
+	return types.ArtifactResponse{
+		Purl:        helpers.PurlString(u.PurlType, u.PurlName, u.Version),
+		Version:     u.Version,
+		DownloadURL: u.DownloadURL,
+		ReleaseDate: u.Date,
+		Licenses:    urlLicenses(u),
+	}
+}
+
+// normaliseHash returns the lowercase form of a hex hash, as stored in the knowledge base.
+func normaliseHash(hash string) string {
+	return strings.ToLower(strings.TrimSpace(hash))
+}
@@ -0,0 +1,177 @@
+// SPDX-License-Identifier: GPL-2.0-or-later
+/*
+ * Copyright (C) 2026 SCANOSS.COM
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 2 of the License, or
+ * (at your option) any later version.
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
+	"github.com/scanoss/go-models/internal/testutils"
+	"github.com/scanoss/go-models/pkg/models"
+	"github.com/scanoss/go-models/pkg/types"
+	zlog "github.com/scanoss/zap-logging-helper/pkg/logger"
+)
+
+const (
+	electronUpdaterPackageHash = "c0763f9bee80979157d3af7ccd0a4b95"
+	electronUpdaterURLHash     = "de3b66fb4c12bd0b68e5d107984e1cf1"
+	tablestyleURLHash          = "686dc352775b58652c9d9ddb2117f402"
+)
+
+func TestGetComponentByHash(t *testing.T) {
+	err := zlog.NewSugaredDevLogger()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a sugared logger", err)
+	}
+	defer zlog.SyncZap()
+	ctx := ctxzap.ToContext(context.Background(), zlog.L)
+	db := testutils.SqliteSetup(t)
+	defer testutils.CloseDB(t, db)
+	testutils.LoadMockSQLData(t, db, "../../internal/testutils/mock")
+
+	service := NewComponentService(models.NewModels(db))
+	mit := []types.License{{ID: 5614, Name: "MIT", SPDXID: "MIT", IsSpdx: true}}
+
+	tests := []struct {
+		name     string
+		hash     string
+		wantPurl string
+		wantType string
+		wantErr  error
+	}{
+		{name: "package hash", hash: electronUpdaterPackageHash, wantPurl: "pkg:npm/electron-updater@4.0.8", wantType: types.HashTypePackage},
+		{name: "package hash upper case", hash: " C0763F9BEE80979157D3AF7CCD0A4B95 ", wantPurl: "pkg:npm/electron-updater@4.0.8", wantType: types.HashTypePackage},
+		{name: "url hash", hash: electronUpdaterURLHash, wantPurl: "pkg:npm/electron-updater@4.0.8", wantType: types.HashTypeURL},
+		{name: "gem url hash", hash: tablestyleURLHash, wantPurl: "pkg:gem/tablestyle@0.0.12", wantType: types.HashTypeURL},
+		{name: "unknown hash", hash: "00000000000000000000000000000000", wantErr: models.ErrNotFound},
+		{name: "empty hash", hash: " ", wantErr: models.ErrInvalidInput},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, getErr := service.GetComponentByHash(ctx, tt.hash)
+			if tt.wantErr != nil {
+				if !errors.Is(getErr, tt.wantErr) {
+					t.Errorf("GetComponentByHash() error = %v, want %v", getErr, tt.wantErr)
+				}
+				return
+			}
+			if getErr != nil {
+				t.Fatalf("GetComponentByHash() unexpected error: %v", getErr)
+			}
+			if res.Purl != tt.wantPurl || res.HashType != tt.wantType {
+				t.Errorf("GetComponentByHash() = %v (%v), want %v (%v)", res.Purl, res.HashType, tt.wantPurl, tt.wantType)
+			}
+			if len(res.Licenses) != 1 || res.Licenses[0] != mit[0] {
+				t.Errorf("GetComponentByHash() licenses = %+v, want %+v", res.Licenses, mit)
+			}
+		})
+	}
+}
+
+func TestGetComponentsByHash(t *testing.T) {
+	err := zlog.NewSugaredDevLogger()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a sugared logger", err)
+	}
+	defer zlog.SyncZap()
+	ctx := ctxzap.ToContext(context.Background(), zlog.L)
+	db := testutils.SqliteSetup(t)
+	defer testutils.CloseDB(t, db)
+	testutils.LoadMockSQLData(t, db, "../../internal/testutils/mock")
+
+	service := NewComponentService(models.NewModels(db))
+	res, err := service.GetComponentsByHash(ctx, []string{tablestyleURLHash, "unknown", electronUpdaterPackageHash, "", tablestyleURLHash})
+	if err != nil {
+		t.Fatalf("GetComponentsByHash() unexpected error: %v", err)
+	}
+	if len(res) != 2 {
+		t.Fatalf("GetComponentsByHash() results = %+v, want 2", res)
+	}
+	if res[0].Hash != tablestyleURLHash || res[0].Version != "0.0.12" || res[0].ReleaseDate != "2013-08-26" {
+		t.Errorf("GetComponentsByHash() unexpected first result: %+v", res[0])
+	}
+	if res[1].Hash != electronUpdaterPackageHash || res[1].DownloadURL != "https://registry.npmjs.org/electron-updater/-/electron-updater-4.0.8.tgz" {
+		t.Errorf("GetComponentsByHash() unexpected second result: %+v", res[1])
+	}
+}
+
+func TestPreferArtifact(t *testing.T) {
+	versioned := models.AllURL{HasVersion: true, Date: "2020-01-01", PurlType: "npm", PurlName: "b"}
+	tests := []struct {
+		name string
+		a, b models.AllURL
+		want bool
+	}{
+		{name: "versioned first", a: versioned, b: models.AllURL{Date: "2019-01-01"}, want: true},
+		{name: "earliest release", a: versioned, b: models.AllURL{HasVersion: true, Date: "2021-01-01"}, want: true},
+		{name: "dated before undated", a: versioned, b: models.AllURL{HasVersion: true}, want: true},
+		{name: "later release", a: versioned, b: models.AllURL{HasVersion: true, Date: "2019-01-01"}, want: false},
+		{name: "purl name tie break", a: versioned, b: models.AllURL{HasVersion: true, Date: "2020-01-01", PurlType: "npm", PurlName: "a"}, want: false},
+	}
+	for _, tt := range tests {
+		if got := preferArtifact(tt.a, tt.b); got != tt.want {
+			t.Errorf("%v: preferArtifact() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestGetComponentByURL(t *testing.T) {
+	err := zlog.NewSugaredDevLogger()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a sugared logger", err)
+	}
+	defer zlog.SyncZap()
+	ctx := ctxzap.ToContext(context.Background(), zlog.L)
+	db := testutils.SqliteSetup(t)
+	defer testutils.CloseDB(t, db)
+	testutils.LoadMockSQLData(t, db, "../../internal/testutils/mock")
+
+	service := NewComponentService(models.NewModels(db))
+	tests := []struct {
+		name     string
+		url      string
+		wantPurl string
+		wantErr  error
+	}{
+		{name: "exact", url: "https://rubygems.org/downloads/tablestyle-0.0.12.gem", wantPurl: "pkg:gem/tablestyle@0.0.12"},
+		{name: "whitespace padded", url: "  https://rubygems.org/downloads/tablestyle-0.0.12.gem\n", wantPurl: "pkg:gem/tablestyle@0.0.12"},
+		{name: "http and www", url: "http://www.rubygems.org/downloads/tablestyle-0.0.12.gem", wantPurl: "pkg:gem/tablestyle@0.0.12"},
+		{name: "host alias and trailing slash", url: "https://registry.yarnpkg.com/electron-updater/-/electron-updater-4.0.8.tgz/", wantPurl: "pkg:npm/electron-updater@4.0.8"},
+		{name: "unknown", url: "https://rubygems.org/downloads/tablestyle-9.9.9.gem", wantErr: models.ErrNotFound},
+		{name: "invalid", url: "ftp://rubygems.org/downloads/tablestyle-0.0.12.gem", wantErr: models.ErrInvalidInput},
+		{name: "empty", url: "", wantErr: models.ErrInvalidInput},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, getErr := service.GetComponentByURL(ctx, tt.url)
+			if tt.wantErr != nil {
+				if !errors.Is(getErr, tt.wantErr) {
+					t.Errorf("GetComponentByURL() error = %v, want %v", getErr, tt.wantErr)
+				}
+				return
+			}
+			if getErr != nil {
+				t.Fatalf("GetComponentByURL() unexpected error: %v", getErr)
+			}
+			if res.Purl != tt.wantPurl || len(res.Licenses) != 1 || res.Licenses[0].Name != "MIT" {
+				t.Errorf("GetComponentByURL() = %+v, want %v (MIT)", res, tt.wantPurl)
+			}
+		})
+	}
+}
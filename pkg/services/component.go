@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/Masterminds/semver/v3"
 	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
@@ -28,59 +29,123 @@ import (
 	purlutils "github.com/scanoss/go-purl-helper/pkg"
 )
 
+// asOfLayout is the layout of ComponentRequest.AsOf dates.
+const asOfLayout = time.DateOnly
+
 // ComponentService orchestrates component lookup logic using extracted business logic.
 type ComponentService struct {
-	models *models.Models
+	models       *models.Models
+	minePriority MinePriority     // Preferred mines of purl types shared by several mines. Nil merges every mine
+	now          func() time.Time // Clock used to age releases, replaceable in tests
 }
 
 // NewComponentService creates a new ComponentService instance.
 // Uses the Models wrapper to access all necessary data access methods.
+// Results from purl types shared by several mines are merged, unless a priority is set with SetMinePriority.
 func NewComponentService(models *models.Models) *ComponentService {
 	return &ComponentService{
 		models: models,
+		now:    time.Now,
 	}
 }
 
+// CheckPurl returns the number of projects matching the given PURL string,
+// restricted to the mines matching any distro or repository_url purl qualifier,
+// and to the projects with artifacts matching any type or classifier purl qualifier.
 func (cs *ComponentService) CheckPurl(ctx context.Context, p string) (int, error) {
+	if err := cs.models.Schema.Check(models.ModelProjects); err != nil {
+		return -1, err
+	}
 	if len(p) == 0 {
-		return -1, errors.New("please specify a valid purl to query")
+		return -1, fmt.Errorf("%w: please specify a valid purl to query", models.ErrInvalidInput)
 	}
 
 	purl, err := purlutils.PurlFromString(p)
 	if err != nil {
-		return -1, fmt.Errorf("failed to parse purl: %w", err)
+		return -1, fmt.Errorf("%w: failed to parse purl: %w", models.ErrInvalidInput, err)
 	}
 
 	purlName, err := purlutils.PurlNameFromString(p) // Make sure we just have the bare minimum for a Purl Name
 	if err != nil {
-		return -1, fmt.Errorf("failed to extract purl name: %w", err)
+		return -1, fmt.Errorf("%w: failed to extract purl name: %w", models.ErrInvalidInput, err)
 	}
 
+	qualifiers := purl.Qualifiers.Map()
+	opts := qualifierOptions(qualifiers, models.URLQueryOptions{})
+	if hasMineQualifiers(qualifiers) {
+		mines, restricted, mineErr := cs.purlMines(ctx, purl.Type, qualifiers)
+		if errors.Is(mineErr, models.ErrNotFound) {
+			return 0, nil
+		}
+		if mineErr != nil {
+			return -1, mineErr
+		}
+		if restricted {
+			if len(mines) == 0 {
+				return 0, nil
+			}
+			for _, mine := range mines {
+				opts.MineIDs = append(opts.MineIDs, mine.ID)
+			}
+		}
+	}
+	if len(opts.FileType) > 0 || len(opts.Classifier) > 0 {
+		projects, projErr := cs.models.Projects.GetProjectsByPurlNameWithOptions(ctx, purlName, purl.Type, opts)
+		if projErr != nil {
+			return -1, projErr
+		}
+		return len(projects), nil
+	}
+	if len(opts.MineIDs) > 0 {
+		return cs.models.Projects.CheckPurlByNameTypeMines(ctx, purlName, purl.Type, opts.MineIDs)
+	}
 	return cs.models.Projects.CheckPurlByNameType(ctx, purlName, purl.Type)
 }
 
 // GetComponent retrieves component information based on PURL and requirements.
 func (cs *ComponentService) GetComponent(ctx context.Context, req types.ComponentRequest) (types.ComponentResponse, error) {
+	return cs.GetComponentWithOptions(ctx, req, models.URLQueryOptions{})
+}
+
+// GetComponentWithOptions retrieves component information based on PURL and requirements,
+// only considering the all_urls rows matching the given options (e.g. a specific mine or date range).
+// If a mine priority is set (see SetMinePriority) and the options do not select a mine, purl types shared by several
+// mines are resolved from the first mine (by priority) with a matching version. Otherwise the versions of every mine
+// are merged. Either way, only the mines matching any distro or repository_url purl qualifier are considered.
+// The type and classifier qualifiers restrict the artifacts considered.
+// Returns ErrInvalidInput for a purl with a subpath, as artifacts are not indexed by the files they contain.
+func (cs *ComponentService) GetComponentWithOptions(ctx context.Context, req types.ComponentRequest, opts models.URLQueryOptions) (types.ComponentResponse, error) {
 	// TODO: Simplify component selection logic.
 	// The code was inspired from scanoss.com/dependencies and heavily refactored
 
+	if err := cs.models.Schema.Check(models.ModelAllUrls); err != nil {
+		return types.ComponentResponse{}, err
+	}
 	if len(req.Purl) == 0 {
-		return types.ComponentResponse{}, errors.New("please specify a valid purl to query")
+		return types.ComponentResponse{}, fmt.Errorf("%w: please specify a valid purl to query", models.ErrInvalidInput)
 	}
 
 	purl, err := purlutils.PurlFromString(req.Purl)
 	if err != nil {
-		return types.ComponentResponse{}, fmt.Errorf("failed to parse purl: %w", err)
+		return types.ComponentResponse{}, fmt.Errorf("%w: failed to parse purl: %w", models.ErrInvalidInput, err)
+	}
+	if len(purl.Subpath) > 0 {
+		return types.ComponentResponse{}, fmt.Errorf("%w: purl subpaths are not supported: %s", models.ErrInvalidInput, purl.Subpath)
 	}
 
 	purlName, err := purlutils.PurlNameFromString(req.Purl) // Make sure we just have the bare minimum for a Purl Name
 	if err != nil {
-		return types.ComponentResponse{}, fmt.Errorf("failed to extract purl name: %w", err)
+		return types.ComponentResponse{}, fmt.Errorf("%w: failed to extract purl name: %w", models.ErrInvalidInput, err)
 	}
 
 	purlReq := req.Requirement
 	if len(purlReq) > 0 && len(purl.Version) > 0 {
-		return types.ComponentResponse{}, errors.New("cannot specify both a version and a requirement")
+		return types.ComponentResponse{}, fmt.Errorf("%w: cannot specify both a version and a requirement", models.ErrInvalidInput)
+	}
+	if len(req.AsOf) > 0 {
+		if _, err = time.Parse(asOfLayout, req.AsOf); err != nil {
+			return types.ComponentResponse{}, fmt.Errorf("%w: invalid as of date, expected YYYY-MM-DD: %w", models.ErrInvalidInput, err)
+		}
 	}
 
 	// Extract an exact version from requirement if no version in PURL
@@ -92,41 +157,119 @@ func (cs *ComponentService) GetComponent(ctx context.Context, req types.Componen
 		}
 	}
 
-	var allUrls []models.AllURL
-	if len(purl.Version) > 0 {
-		allUrls, err = cs.models.AllUrls.GetURLsByPurlNameTypeVersion(ctx, purlName, purl.Type, purl.Version)
-	} else {
-		allUrls, err = cs.models.AllUrls.GetURLsByPurlNameType(ctx, purlName, purl.Type)
-	}
-
+	qualifiers := purl.Qualifiers.Map()
+	opts = qualifierOptions(qualifiers, opts)
+	mines, err := cs.rankedMines(ctx, purl.Type, qualifiers, opts)
 	if err != nil {
 		return types.ComponentResponse{}, err
 	}
 
-	allUrl, err := cs.pickOneUrl(ctx, allUrls, purlName, purl.Type, purlReq)
+	var version models.URLVersion
+	var candidates int
+	for _, mineOpts := range mineOptions(opts, mines) {
+		var versions []models.URLVersion
+		if len(purl.Version) > 0 {
+			versions, err = cs.models.AllUrls.GetURLVersionsByPurlNameTypeVersionWithOptions(ctx, purlName, purl.Type, purl.Version, mineOpts)
+		} else {
+			versions, err = cs.models.AllUrls.GetURLVersionsByPurlNameTypeWithOptions(ctx, purlName, purl.Type, mineOpts)
+		}
+		if err != nil {
+			return types.ComponentResponse{}, err
+		}
+		candidates += len(versions)
+
+		version, err = cs.pickOneVersion(ctx, versions, purlName, purl.Type, purlReq, req.AsOf)
+		if err != nil {
+			return types.ComponentResponse{}, err
+		}
+		if len(version.Version) > 0 {
+			opts = mineOpts
+			break
+		}
+	}
+
+	if len(version.Version) == 0 {
+		if candidates > 0 && len(purlReq) > 0 {
+			return types.ComponentResponse{}, fmt.Errorf("%w: cannot find version for purl %s matching %s", models.ErrNoVersionMatch, req.Purl, purlReq)
+		}
+		if candidates > 0 && len(req.AsOf) > 0 {
+			return types.ComponentResponse{}, fmt.Errorf("%w: cannot find version for purl %s released by %s", models.ErrNoVersionMatch, req.Purl, req.AsOf)
+		}
+		return types.ComponentResponse{}, fmt.Errorf("%w: cannot find version for purl %s", models.ErrNotFound, req.Purl)
+	}
+
+	allUrl, err := cs.pickOneUrl(ctx, version, purlName, purl.Type, req.AsOf, opts)
 	if err != nil {
 		return types.ComponentResponse{}, err
 	}
 
-	if len(allUrl.Version) == 0 {
-		return types.ComponentResponse{}, fmt.Errorf("cannot find version for purl %s", req.Purl)
+	res := types.ComponentResponse{
+		Purl:        req.Purl,
+		Version:     version.Version,
+		URL:         allUrl.URL,
+		Vendor:      allUrl.Vendor,
+		DownloadURL: allUrl.DownloadURL,
+		URLHash:     allUrl.URLHash,
+		PackageHash: allUrl.PackageHash,
+		ReleaseDate: version.EarliestDate,
+		Licenses:    versionLicenses(version),
+	}
+	return res, nil
+}
+
+// pickOneUrl selects the most recent artifact of the given version matching the options (and released by asOf, if set),
+// and fills in its project URL.
+func (cs *ComponentService) pickOneUrl(ctx context.Context, version models.URLVersion, purlName, purlType, asOf string, opts models.URLQueryOptions) (models.AllURL, error) {
+	opts.Order = models.URLOrderDateDesc
+	if len(asOf) > 0 {
+		asOfDay, err := time.Parse(asOfLayout, asOf)
+		if err != nil {
+			return models.AllURL{}, fmt.Errorf("%w: invalid as of date, expected YYYY-MM-DD: %w", models.ErrInvalidInput, err)
+		}
+		// An unparsable DateTo is left for the model to reject
+		if dateTo, toErr := time.Parse(asOfLayout, opts.DateTo); len(opts.DateTo) == 0 || (toErr == nil && asOfDay.Before(dateTo)) {
+			opts.DateTo = asOfDay.Format(asOfLayout)
+		}
+	}
+	bestURL, err := cs.models.AllUrls.GetURLByPurlNameTypeVersionID(ctx, purlName, purlType, version.VersionID, opts)
+	if err != nil && !errors.Is(err, models.ErrNotFound) {
+		return models.AllURL{}, err
+	}
+	bestURL.URL, _ = purlutils.ProjectUrl(purlName, purlType)
+	ctxzap.Extract(ctx).Sugar().Debugf("Selected version: %#v", bestURL)
+	return bestURL, nil
+}
+
+// versionLicenses returns the licenses declared for a version.
+func versionLicenses(version models.URLVersion) []types.License {
+	var licenses []types.License
+	for _, l := range version.Licenses {
+		if len(l.LicenseName) > 0 {
+			licenses = append(licenses, types.License{ID: l.ID, Name: l.LicenseName, SPDXID: l.SPDX, IsSpdx: l.IsSpdx})
+		}
 	}
+	return licenses
+}
 
-	return types.ComponentResponse{
-		Purl:    req.Purl,
-		Version: allUrl.Version,
-	}, nil
+// urlLicenses returns the license declared for an all_urls row (if any).
+func urlLicenses(u models.AllURL) []types.License {
+	if !u.HasLicense || len(u.License) == 0 {
+		return nil
+	}
+	return []types.License{{ID: u.LicenseID, Name: u.License, SPDXID: u.SPDXID, IsSpdx: u.IsSpdx}}
 }
 
-// pickOneUrl takes the potential matching component/versions and selects the most appropriate one.
+// pickOneVersion takes the potential matching component versions and selects the most appropriate one.
+// If asOf is set, versions released after that date (or without a known release date) are excluded.
+// Returns ErrInvalidInput if the asOf date cannot be parsed.
 //
 //nolint:unparam // error kept for future use
-func (cs *ComponentService) pickOneUrl(ctx context.Context, allUrls []models.AllURL, purlName, purlType, purlReq string) (models.AllURL, error) {
+func (cs *ComponentService) pickOneVersion(ctx context.Context, versions []models.URLVersion, purlName, purlType, purlReq, asOf string) (models.URLVersion, error) {
 	s := ctxzap.Extract(ctx).Sugar()
 
-	if len(allUrls) == 0 {
+	if len(versions) == 0 {
 		s.Infof("No component match (in urls) found for %v, %v", purlName, purlType)
-		return models.AllURL{}, nil
+		return models.URLVersion{}, nil
 	}
 
 	var c *semver.Constraints
@@ -139,44 +282,70 @@ func (cs *ComponentService) pickOneUrl(ctx context.Context, allUrls []models.All
 		}
 	}
 
-	zeroVersion, _ := semver.NewVersion("v0.0.0")
+	var asOfDay time.Time
+	if len(asOf) > 0 {
+		var err error
+		if asOfDay, err = time.Parse(asOfLayout, asOf); err != nil {
+			return models.URLVersion{}, fmt.Errorf("%w: invalid as of date, expected YYYY-MM-DD: %w", models.ErrInvalidInput, err)
+		}
+	}
+
 	var bestVersion *semver.Version
-	var bestURL models.AllURL
+	var best models.URLVersion
 
 	s.Debugf("Checking versions...")
-	for _, url := range allUrls {
-		if len(url.SemVer) == 0 && len(url.Version) == 0 {
-			s.Infof("Skipping match as it doesn't have a version: %#v", url)
+	for _, ver := range versions {
+		if len(ver.SemVer) == 0 && len(ver.Version) == 0 {
+			s.Infof("Skipping match as it doesn't have a version: %#v", ver)
 			continue
 		}
-
-		v, err := semver.NewVersion(url.Version)
-		if err != nil && len(url.SemVer) > 0 {
-			s.Debugf("Failed to parse SemVer: '%v'. Trying Version instead: %v (%v)", url.Version, url.SemVer, err)
-			v, err = semver.NewVersion(url.SemVer)
-		}
-		if err != nil {
-			s.Warnf("Encountered an issue parsing version string '%v' (%v) for %v: %v. Using v0.0.0", url.Version, url.SemVer, url, err)
-			v = zeroVersion
+		if len(asOf) > 0 {
+			released, dateErr := time.Parse(asOfLayout, releaseDay(ver.EarliestDate))
+			if dateErr != nil || released.After(asOfDay) {
+				s.Debugf("Skipping version %v released after %v (or without a known date): %v", ver.Version, asOf, ver.EarliestDate)
+				continue
+			}
 		}
 
+		v := parseVersion(ctx, ver)
 		if c != nil && !c.Check(v) {
 			continue
 		}
 
 		if bestVersion == nil || v.GreaterThan(bestVersion) {
 			bestVersion = v
-			bestURL = url
+			best = ver
 		}
 	}
 
 	if bestVersion == nil { // TODO should we return the latest version anyway?
 		s.Warnf("No component match found for %v, %v after filter %v", purlName, purlType, purlReq)
-		return models.AllURL{}, nil
+		return models.URLVersion{}, nil
 	}
 
 	s.Debugf("Selected highest version: %v", bestVersion)
-	bestURL.URL, _ = purlutils.ProjectUrl(purlName, purlType)
-	s.Debugf("Selected version: %#v", bestURL)
-	return bestURL, nil
+	return best, nil
+}
+
+// parseVersion parses the version name of a component version, falling back to its semver, or v0.0.0 if neither parse.
+func parseVersion(ctx context.Context, ver models.URLVersion) *semver.Version {
+	s := ctxzap.Extract(ctx).Sugar()
+	v, err := semver.NewVersion(ver.Version)
+	if err != nil && len(ver.SemVer) > 0 {
+		s.Debugf("Failed to parse SemVer: '%v'. Trying Version instead: %v (%v)", ver.Version, ver.SemVer, err)
+		v, err = semver.NewVersion(ver.SemVer)
+	}
+	if err != nil {
+		s.Warnf("Encountered an issue parsing version string '%v' (%v) for %v: %v. Using v0.0.0", ver.Version, ver.SemVer, ver, err)
+		v, _ = semver.NewVersion("v0.0.0")
+	}
+	return v
+}
+
+// releaseDay returns the YYYY-MM-DD part of an all_urls date.
+func releaseDay(date string) string {
+	if len(date) > len(asOfLayout) {
+		return date[:len(asOfLayout)]
+	}
+	return date
 }
@@ -18,6 +18,7 @@ package services
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
@@ -125,7 +126,7 @@ func TestGetComponentValidPurlButInvalidPurlName(t *testing.T) {
 	}
 }
 
-func TestPickOneUrl(t *testing.T) {
+func TestPickOneVersion(t *testing.T) {
 	err := zlog.NewSugaredDevLogger()
 	if err != nil {
 		t.Fatalf("an error '%s' was not expected when opening a sugared logger", err)
@@ -142,17 +143,18 @@ func TestPickOneUrl(t *testing.T) {
 
 	tests := []struct {
 		name          string
-		urls          []models.AllURL
+		versions      []models.URLVersion
 		component     string
 		purlType      string
 		requirement   string
+		asOf          string
 		expectedVer   string
 		shouldError   bool
 		expectedEmpty bool
 	}{
 		{
 			name:          "empty urls",
-			urls:          []models.AllURL{},
+			versions:      []models.URLVersion{},
 			component:     "",
 			purlType:      "",
 			requirement:   "",
@@ -162,20 +164,14 @@ func TestPickOneUrl(t *testing.T) {
 		},
 		{
 			name: "multiple versions - picks highest",
-			urls: []models.AllURL{
+			versions: []models.URLVersion{
 				{
-					Component: "lodash",
-					Version:   "1.0.0",
-					SemVer:    "1.0.0",
-					PurlName:  "lodash",
-					MineID:    2,
+					Version: "1.0.0",
+					SemVer:  "1.0.0",
 				},
 				{
-					Component: "lodash",
-					Version:   "2.0.0",
-					SemVer:    "2.0.0",
-					PurlName:  "lodash",
-					MineID:    2,
+					Version: "2.0.0",
+					SemVer:  "2.0.0",
 				},
 			},
 			component:     "lodash",
@@ -187,20 +183,14 @@ func TestPickOneUrl(t *testing.T) {
 		},
 		{
 			name: "version constraints filtering",
-			urls: []models.AllURL{
+			versions: []models.URLVersion{
 				{
-					Component: "lodash",
-					Version:   "v1.0.0",
-					SemVer:    "1.0.0",
-					PurlName:  "lodash",
-					MineID:    2,
+					Version: "v1.0.0",
+					SemVer:  "1.0.0",
 				},
 				{
-					Component: "lodash",
-					Version:   "v2.0.0",
-					SemVer:    "2.0.0",
-					PurlName:  "lodash",
-					MineID:    2,
+					Version: "v2.0.0",
+					SemVer:  "2.0.0",
 				},
 			},
 			component:     "lodash",
@@ -212,13 +202,10 @@ func TestPickOneUrl(t *testing.T) {
 		},
 		{
 			name: "no versions after filter",
-			urls: []models.AllURL{
+			versions: []models.URLVersion{
 				{
-					Component: "lodash",
-					Version:   "1.0.0",
-					SemVer:    "1.0.0",
-					PurlName:  "lodash",
-					MineID:    2,
+					Version: "1.0.0",
+					SemVer:  "1.0.0",
 				},
 			},
 			component:     "lodash",
@@ -228,11 +215,84 @@ func TestPickOneUrl(t *testing.T) {
 			shouldError:   false,
 			expectedEmpty: true,
 		},
+		{
+			name: "unparsable requirement is ignored",
+			versions: []models.URLVersion{
+				{
+					Version: "1.0.0",
+					SemVer:  "1.0.0",
+				},
+			},
+			component:     "lodash",
+			purlType:      "npm",
+			requirement:   "not-a-constraint",
+			expectedVer:   "1.0.0",
+			shouldError:   false,
+			expectedEmpty: false,
+		},
+		{
+			name: "as of date ignores unknown and time of day",
+			versions: []models.URLVersion{
+				{Version: "1.0.0", SemVer: "1.0.0", EarliestDate: "2021-06-01 23:59:59"},
+				{Version: "2.0.0", SemVer: "2.0.0", EarliestDate: "unknown"},
+			},
+			component:   "lodash",
+			purlType:    "npm",
+			asOf:        "2021-06-01",
+			expectedVer: "1.0.0",
+		},
+		{
+			name: "invalid as of date",
+			versions: []models.URLVersion{
+				{Version: "1.0.0", SemVer: "1.0.0", EarliestDate: "2021-01-10"},
+			},
+			component:     "lodash",
+			purlType:      "npm",
+			asOf:          "2021/06/01",
+			shouldError:   true,
+			expectedEmpty: true,
+		},
+		{
+			name: "as of date excludes later versions",
+			versions: []models.URLVersion{
+				{Version: "1.0.0", SemVer: "1.0.0", EarliestDate: "2021-01-10"},
+				{Version: "1.1.0", SemVer: "1.1.0", EarliestDate: "2021-06-01"},
+				{Version: "2.0.0", SemVer: "2.0.0", EarliestDate: "2021-06-02"},
+				{Version: "3.0.0", SemVer: "3.0.0"},
+			},
+			component:   "lodash",
+			purlType:    "npm",
+			asOf:        "2021-06-01",
+			expectedVer: "1.1.0",
+		},
+		{
+			name: "as of date with requirement",
+			versions: []models.URLVersion{
+				{Version: "1.0.0", SemVer: "1.0.0", EarliestDate: "2021-01-10"},
+				{Version: "1.1.0", SemVer: "1.1.0", EarliestDate: "2021-06-01"},
+				{Version: "2.0.0", SemVer: "2.0.0", EarliestDate: "2021-01-01"},
+			},
+			component:   "lodash",
+			purlType:    "npm",
+			requirement: "^1.0.0",
+			asOf:        "2021-05-31",
+			expectedVer: "1.0.0",
+		},
+		{
+			name: "as of date before first release",
+			versions: []models.URLVersion{
+				{Version: "1.0.0", SemVer: "1.0.0", EarliestDate: "2021-01-10"},
+			},
+			component:     "lodash",
+			purlType:      "npm",
+			asOf:          "2020-12-31",
+			expectedEmpty: true,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result, pickErr := service.pickOneUrl(ctx, tt.urls, tt.component, tt.purlType, tt.requirement)
+			result, pickErr := service.pickOneVersion(ctx, tt.versions, tt.component, tt.purlType, tt.requirement, tt.asOf)
 
 			if tt.shouldError && pickErr == nil {
 				t.Error("expected error but got none")
@@ -273,14 +333,17 @@ func TestGetComponent(t *testing.T) {
 		name        string
 		purl        string
 		requirement string
+		asOf        string
 		expectedVer string
 		shouldError bool
+		wantErr     error
 	}{
 		{
 			name:        "empty purl",
 			purl:        "",
 			requirement: "",
 			shouldError: true,
+			wantErr:     models.ErrInvalidInput,
 			// Expected error because GetComponent requires a non-empty PURL string
 		},
 		{
@@ -288,6 +351,7 @@ func TestGetComponent(t *testing.T) {
 			purl:        "invalid-purl-format",
 			requirement: "",
 			shouldError: true,
+			wantErr:     models.ErrInvalidInput,
 			// Expected error because the string doesn't follow PURL format (pkg:type/name@version)
 		},
 		{
@@ -359,6 +423,7 @@ func TestGetComponent(t *testing.T) {
 			purl:        "pkg:npm/non-existent-package",
 			requirement: "",
 			shouldError: true,
+			wantErr:     models.ErrNotFound,
 			// Expected error because component doesn't exist in mock database
 		},
 		{
@@ -366,6 +431,7 @@ func TestGetComponent(t *testing.T) {
 			purl:        "pkg:npm/electron-updater",
 			requirement: "^99.0.0",
 			shouldError: true,
+			wantErr:     models.ErrNoVersionMatch,
 			// Expected error because no electron-updater versions match ^99.0.0 constraint
 		},
 		{
@@ -376,6 +442,41 @@ func TestGetComponent(t *testing.T) {
 			shouldError: false,
 			// Expected version 4.0.8 because requirement contains exact version (no PURL version), extracts 4.0.8
 		},
+		{
+			name:        "requirement as of a date",
+			purl:        "pkg:npm/electron-updater",
+			requirement: "^4.0.0",
+			asOf:        "2021-06-01",
+			expectedVer: "4.3.9",
+			// Expected version 4.3.9 because 4.3.10 was released on 2021-06-11
+		},
+		{
+			name:        "as of the release date",
+			purl:        "pkg:npm/electron-updater",
+			asOf:        "2021-05-11",
+			expectedVer: "4.3.9",
+		},
+		{
+			name:        "pinned version released after the date",
+			purl:        "pkg:npm/electron-updater@4.3.10",
+			asOf:        "2021-06-01",
+			shouldError: true,
+			wantErr:     models.ErrNoVersionMatch,
+		},
+		{
+			name:        "as of a date before any release",
+			purl:        "pkg:npm/electron-updater",
+			asOf:        "2010-01-01",
+			shouldError: true,
+			wantErr:     models.ErrNoVersionMatch,
+		},
+		{
+			name:        "invalid as of date",
+			purl:        "pkg:npm/electron-updater",
+			asOf:        "June 2021",
+			shouldError: true,
+			wantErr:     models.ErrInvalidInput,
+		},
 	}
 
 	for _, tt := range tests {
@@ -383,6 +484,7 @@ func TestGetComponent(t *testing.T) {
 			req := types.ComponentRequest{
 				Purl:        tt.purl,
 				Requirement: tt.requirement,
+				AsOf:        tt.asOf,
 			}
 
 			result, getErr := service.GetComponent(ctx, req)
@@ -392,6 +494,9 @@ func TestGetComponent(t *testing.T) {
 					t.Error("expected error but got none")
 					return
 				}
+				if tt.wantErr != nil && !errors.Is(getErr, tt.wantErr) {
+					t.Errorf("expected error %v, got %v", tt.wantErr, getErr)
+				}
 			} else {
 				if getErr != nil {
 					t.Errorf("unexpected error: %v", getErr)
@@ -407,3 +512,188 @@ func TestGetComponent(t *testing.T) {
 		})
 	}
 }
+
+func TestComponentServiceIncompatibleSchema(t *testing.T) {
+	err := zlog.NewSugaredDevLogger()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a sugared logger", err)
+	}
+	defer zlog.SyncZap()
+	ctx := ctxzap.ToContext(context.Background(), zlog.L)
+	db := testutils.SqliteSetup(t)
+	defer testutils.CloseDB(t, db)
+
+	testutils.LoadMockSQLData(t, db, "../../internal/testutils/mock")
+	testutils.LoadSQLDataFile(t, db, "../../internal/testutils/mock/db_version.sql")
+	if _, err = db.Exec("UPDATE db_version SET schema_version = '2.0.0'"); err != nil {
+		t.Fatalf("failed to update db_version table: %v", err)
+	}
+
+	service := NewComponentService(models.NewModels(db))
+
+	_, err = service.GetComponent(ctx, types.ComponentRequest{Purl: "pkg:npm/react"})
+	if !errors.Is(err, models.ErrSchemaIncompatible) {
+		t.Errorf("GetComponent() expected ErrSchemaIncompatible, got %v", err)
+	}
+	_, err = service.CheckPurl(ctx, "pkg:gem/tablestyle")
+	if !errors.Is(err, models.ErrSchemaIncompatible) {
+		t.Errorf("CheckPurl() expected ErrSchemaIncompatible, got %v", err)
+	}
+}
+
+func TestGetComponentLicensesAndURL(t *testing.T) {
+	err := zlog.NewSugaredDevLogger()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a sugared logger", err)
+	}
+	defer zlog.SyncZap()
+	ctx := ctxzap.ToContext(context.Background(), zlog.L)
+	db := testutils.SqliteSetup(t)
+	defer testutils.CloseDB(t, db)
+	testutils.LoadMockSQLData(t, db, "../../internal/testutils/mock")
+
+	service := NewComponentService(models.NewModels(db))
+	result, err := service.GetComponent(ctx, types.ComponentRequest{Purl: "pkg:npm/electron-updater@4.0.8"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.URL != "https://www.npmjs.com/package/electron-updater" {
+		t.Errorf("expected npm project url, got %v", result.URL)
+	}
+	want := types.License{ID: 5614, Name: "MIT", SPDXID: "MIT", IsSpdx: true}
+	if len(result.Licenses) != 1 || result.Licenses[0] != want {
+		t.Errorf("expected licenses %+v, got %+v", want, result.Licenses)
+	}
+	if result.DownloadURL != "https://registry.npmjs.org/electron-updater/-/electron-updater-4.0.8.tgz" {
+		t.Errorf("expected npm download url, got %v", result.DownloadURL)
+	}
+	if result.PackageHash != "c0763f9bee80979157d3af7ccd0a4b95" || result.URLHash != "de3b66fb4c12bd0b68e5d107984e1cf1" {
+		t.Errorf("unexpected hashes: %v, %v", result.PackageHash, result.URLHash)
+	}
+	if result.ReleaseDate != "2019-02-26" || result.Vendor != "Vladimir Krivosheev" {
+		t.Errorf("unexpected release date or vendor: %v, %v", result.ReleaseDate, result.Vendor)
+	}
+}
+
+func TestGetComponentMultipleArtifacts(t *testing.T) {
+	err := zlog.NewSugaredDevLogger()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a sugared logger", err)
+	}
+	defer zlog.SyncZap()
+	ctx := ctxzap.ToContext(context.Background(), zlog.L)
+	db := testutils.SqliteSetup(t)
+	defer testutils.CloseDB(t, db)
+	testutils.LoadMockSQLData(t, db, "../../internal/testutils/mock")
+	testutils.LoadSQLDataFile(t, db, "../../internal/testutils/mock/multi_artifact.sql")
+
+	service := NewComponentService(models.NewModels(db))
+	result, err := service.GetComponent(ctx, types.ComponentRequest{Purl: "pkg:npm/multi-artifact", Requirement: "^1.0.0"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Version != "1.0.0" || result.ReleaseDate != "2024-03-01" {
+		t.Errorf("unexpected version or release date: %v, %v", result.Version, result.ReleaseDate)
+	}
+	// Licenses are gathered from every artifact of the version, the download details from the newest one
+	if len(result.Licenses) != 2 || result.Licenses[0].SPDXID != "Apache-2.0" || result.Licenses[1].SPDXID != "MIT" {
+		t.Errorf("unexpected licenses: %+v", result.Licenses)
+	}
+	if result.DownloadURL != "https://registry.npmjs.org/multi-artifact/-/multi-artifact-1.0.0-apache.tgz" {
+		t.Errorf("unexpected download url: %v", result.DownloadURL)
+	}
+}
+
+func TestGetComponentWithOptions(t *testing.T) {
+	err := zlog.NewSugaredDevLogger()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a sugared logger", err)
+	}
+	defer zlog.SyncZap()
+	ctx := ctxzap.ToContext(context.Background(), zlog.L)
+	db := testutils.SqliteSetup(t)
+	defer testutils.CloseDB(t, db)
+	testutils.LoadMockSQLData(t, db, "../../internal/testutils/mock")
+	testutils.LoadSQLDataFile(t, db, "../../internal/testutils/mock/multi_artifact.sql")
+
+	service := NewComponentService(models.NewModels(db))
+	tests := []struct {
+		name        string
+		purl        string
+		opts        models.URLQueryOptions
+		wantVer     string
+		wantLicense string
+		wantErr     error
+	}{
+		{name: "no options", purl: "pkg:npm/multi-artifact", wantVer: "2.0.0", wantLicense: "MIT"},
+		{name: "date range", purl: "pkg:npm/multi-artifact", opts: models.URLQueryOptions{DateFrom: "2024-03-04", DateTo: "2024-03-31"}, wantVer: "1.0.0", wantLicense: "Apache-2.0"},
+		{name: "license", purl: "pkg:npm/multi-artifact", opts: models.URLQueryOptions{LicenseID: 850}, wantVer: "1.0.0", wantLicense: "Apache-2.0"},
+		{name: "license and date", purl: "pkg:npm/multi-artifact", opts: models.URLQueryOptions{LicenseID: 5614, DateTo: "2024-03-31"}, wantVer: "1.0.0", wantLicense: "MIT"},
+		{name: "other mine", purl: "pkg:npm/multi-artifact", opts: models.URLQueryOptions{MineName: "nodejs.org"}, wantErr: models.ErrNotFound},
+		{name: "pinned version filtered out", purl: "pkg:npm/multi-artifact@2.0.0", opts: models.URLQueryOptions{DateTo: "2024-03-31"}, wantErr: models.ErrNotFound},
+		{name: "invalid options", purl: "pkg:npm/multi-artifact", opts: models.URLQueryOptions{DateFrom: "March"}, wantErr: models.ErrInvalidInput},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := service.GetComponentWithOptions(ctx, types.ComponentRequest{Purl: tt.purl}, tt.opts)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("expected error %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if result.Version != tt.wantVer {
+				t.Errorf("expected version %s, got %s", tt.wantVer, result.Version)
+			}
+			// Licenses are only gathered from the matching artifacts
+			if len(result.Licenses) != 1 || result.Licenses[0].SPDXID != tt.wantLicense {
+				t.Errorf("expected license %s, got %+v", tt.wantLicense, result.Licenses)
+			}
+		})
+	}
+}
+
+func TestPickOneUrlAsOf(t *testing.T) {
+	err := zlog.NewSugaredDevLogger()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a sugared logger", err)
+	}
+	defer zlog.SyncZap()
+	ctx := ctxzap.ToContext(context.Background(), zlog.L)
+	db := testutils.SqliteSetup(t)
+	defer testutils.CloseDB(t, db)
+	testutils.LoadMockSQLData(t, db, "../../internal/testutils/mock")
+	testutils.LoadSQLDataFile(t, db, "../../internal/testutils/mock/multi_artifact.sql")
+
+	service := NewComponentService(models.NewModels(db))
+	version := models.URLVersion{Version: "1.0.0", VersionID: 400387}
+	tests := []struct {
+		name     string
+		asOf     string
+		dateTo   string
+		wantDate string
+		wantErr  error
+	}{
+		{name: "no dates", wantDate: "2024-03-05"},
+		{name: "as of before date to", asOf: "2024-03-03", dateTo: "2024-03-31", wantDate: "2024-03-03"},
+		{name: "date to before as of", asOf: "2024-03-31", dateTo: "2024-03-02", wantDate: "2024-03-01"},
+		{name: "invalid as of", asOf: "3 March 2024", wantErr: models.ErrInvalidInput},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			url, pickErr := service.pickOneUrl(ctx, version, "multi-artifact", "npm", tt.asOf, models.URLQueryOptions{DateTo: tt.dateTo})
+			if tt.wantErr != nil {
+				if !errors.Is(pickErr, tt.wantErr) {
+					t.Errorf("pickOneUrl() error = %v, want %v", pickErr, tt.wantErr)
+				}
+				return
+			}
+			if pickErr != nil || url.Date != tt.wantDate {
+				t.Errorf("pickOneUrl() = %v (%v), want %v", url.Date, pickErr, tt.wantDate)
+			}
+		})
+	}
+}
@@ -0,0 +1,172 @@
+// SPDX-License-Identifier: GPL-2.0-or-later
+/*
+ * Copyright (C) 2026 SCANOSS.COM
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 2 of the License, or
+ * (at your option) any later version.
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
+	"github.com/scanoss/go-models/pkg/helpers"
+	"github.com/scanoss/go-models/pkg/models"
+	"github.com/scanoss/go-models/pkg/types"
+)
+
+// DiffService compares the contents of two knowledge base releases.
+type DiffService struct {
+	oldModels *models.Models
+	newModels *models.Models
+}
+
+// NewDiffService creates a new DiffService instance comparing the old and new knowledge bases.
+func NewDiffService(oldModels, newModels *models.Models) *DiffService {
+	return &DiffService{
+		oldModels: oldModels,
+		newModels: newModels,
+	}
+}
+
+// Diff reports the projects added and removed, the new versions per purl,
+// and the license changes per component between the old and new knowledge bases.
+// Every version of an added project is reported as new.
+// Each knowledge base is read with one query for its projects, one for its versions and one for its licenses.
+func (ds *DiffService) Diff(ctx context.Context) (types.DatabaseDiff, error) {
+	s := ctxzap.Extract(ctx).Sugar()
+	diff := types.DatabaseDiff{
+		OldRelease:      dbRelease(ctx, ds.oldModels),
+		NewRelease:      dbRelease(ctx, ds.newModels),
+		AddedProjects:   []string{},
+		RemovedProjects: []string{},
+		NewVersions:     []types.VersionChange{},
+		LicenseChanges:  []types.LicenseChange{},
+	}
+	oldPurls, err := projectPurls(ctx, ds.oldModels)
+	if err != nil {
+		return types.DatabaseDiff{}, fmt.Errorf("failed to list old projects: %w", err)
+	}
+	newPurls, err := projectPurls(ctx, ds.newModels)
+	if err != nil {
+		return types.DatabaseDiff{}, fmt.Errorf("failed to list new projects: %w", err)
+	}
+	oldVersions, err := purlVersions(ctx, ds.oldModels)
+	if err != nil {
+		return types.DatabaseDiff{}, fmt.Errorf("failed to list old versions: %w", err)
+	}
+	newVersions, err := purlVersions(ctx, ds.newModels)
+	if err != nil {
+		return types.DatabaseDiff{}, fmt.Errorf("failed to list new versions: %w", err)
+	}
+	oldLicenses, err := purlLicenses(ctx, ds.oldModels)
+	if err != nil {
+		return types.DatabaseDiff{}, fmt.Errorf("failed to list old licenses: %w", err)
+	}
+	newLicenses, err := purlLicenses(ctx, ds.newModels)
+	if err != nil {
+		return types.DatabaseDiff{}, fmt.Errorf("failed to list new licenses: %w", err)
+	}
+	for purl := range oldPurls {
+		if _, ok := newPurls[purl]; !ok {
+			diff.RemovedProjects = append(diff.RemovedProjects, purl)
+		}
+	}
+	purls := make([]string, 0, len(newPurls))
+	for purl := range newPurls {
+		purls = append(purls, purl)
+		if _, ok := oldPurls[purl]; !ok {
+			diff.AddedProjects = append(diff.AddedProjects, purl)
+		}
+	}
+	sort.Strings(diff.AddedProjects)
+	sort.Strings(diff.RemovedProjects)
+	sort.Strings(purls)
+	s.Debugf("Comparing %v projects (%v added, %v removed)", len(purls), len(diff.AddedProjects), len(diff.RemovedProjects))
+
+	for _, purl := range purls {
+		_, existed := oldPurls[purl]
+		var added []string
+		for _, version := range newVersions[purl] {
+			if _, found := slices.BinarySearch(oldVersions[purl], version); !existed || !found {
+				added = append(added, version)
+			}
+		}
+		if len(added) > 0 {
+			diff.NewVersions = append(diff.NewVersions, types.VersionChange{Purl: purl, Versions: added})
+		}
+		if existed && !slices.Equal(oldLicenses[purl], newLicenses[purl]) {
+			diff.LicenseChanges = append(diff.LicenseChanges, types.LicenseChange{Purl: purl, OldLicenses: oldLicenses[purl], NewLicenses: newLicenses[purl]})
+		}
+	}
+	return diff, nil
+}
+
+// dbRelease returns the db_release of the given knowledge base, or an empty string if unknown.
+func dbRelease(ctx context.Context, m *models.Models) string {
+	version, err := m.DBVersion.GetCurrentVersion(ctx)
+	if err != nil {
+		if !errors.Is(err, models.ErrTableNotFound) && !errors.Is(err, models.ErrNotFound) {
+			ctxzap.Extract(ctx).Sugar().Warnf("Problem getting db release: %v", err)
+		}
+		return ""
+	}
+	return version.DBRelease
+}
+
+// projectPurls returns the projects of the given knowledge base keyed by purl (without version).
+func projectPurls(ctx context.Context, m *models.Models) (map[string]models.ProjectPurl, error) {
+	purls, err := m.Projects.GetAllProjectPurls(ctx)
+	if err != nil {
+		return nil, err
+	}
+	projects := make(map[string]models.ProjectPurl, len(purls))
+	for _, p := range purls {
+		projects[helpers.PurlString(p.PurlType, p.PurlName, "")] = p
+	}
+	return projects, nil
+}
+
+// purlVersions returns the versions of every component in the given knowledge base, keyed by purl (without version).
+func purlVersions(ctx context.Context, m *models.Models) (map[string][]string, error) {
+	rows, err := m.AllUrls.GetAllPurlVersions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Rows are ordered by version, so each slice is sorted as required by slices.BinarySearch
+	versions := make(map[string][]string)
+	for _, row := range rows {
+		purl := helpers.PurlString(row.PurlType, row.PurlName, "")
+		versions[purl] = append(versions[purl], row.Version)
+	}
+	return versions, nil
+}
+
+// purlLicenses returns the sorted, distinct licenses (including git licenses) of every project
+// in the given knowledge base, keyed by purl (without version).
+func purlLicenses(ctx context.Context, m *models.Models) (map[string][]string, error) {
+	rows, err := m.Projects.GetAllProjectLicenses(ctx)
+	if err != nil {
+		return nil, err
+	}
+	licenses := make(map[string][]string)
+	for _, row := range rows {
+		purl := helpers.PurlString(row.PurlType, row.PurlName, "")
+		licenses[purl] = append(licenses[purl], row.License)
+	}
+	return licenses, nil
+}
@@ -0,0 +1,130 @@
+// SPDX-License-Identifier: GPL-2.0-or-later
+/*
+ * Copyright (C) 2026 SCANOSS.COM
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 2 of the License, or
+ * (at your option) any later version.
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
+	"github.com/scanoss/go-models/internal/testutils"
+	"github.com/scanoss/go-models/pkg/models"
+	zlog "github.com/scanoss/zap-logging-helper/pkg/logger"
+)
+
+func TestDiff(t *testing.T) {
+	err := zlog.NewSugaredDevLogger()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a sugared logger", err)
+	}
+	defer zlog.SyncZap()
+	ctx := ctxzap.ToContext(context.Background(), zlog.L)
+	oldDB := testutils.SqliteSetupNamed(t, "diff_old")
+	defer testutils.CloseDB(t, oldDB)
+	newDB := testutils.SqliteSetupNamed(t, "diff_new")
+	defer testutils.CloseDB(t, newDB)
+
+	testutils.LoadMockSQLData(t, oldDB, "../../internal/testutils/mock")
+	testutils.LoadMockSQLData(t, newDB, "../../internal/testutils/mock")
+	testutils.LoadSQLDataFile(t, newDB, "../../internal/testutils/mock/db_version.sql")
+
+	changes := []string{
+		"DELETE FROM projects WHERE purl_name = 'node-blob' AND mine_id = 2",
+		"INSERT INTO projects (mine_id, vendor, component, purl_name, license_id, git_license_id)" +
+			" VALUES (2, 'scanoss', 'new-package', 'new-package', 5614, 9999)",
+		"INSERT INTO all_urls (package_hash, vendor, component, version, date, url, url_hash, mine_id, license, purl_name, version_id, license_id)" +
+			" VALUES ('hash', 'taballa.hp-PD', 'tablestyle', '0.0.13', '2014-01-01', 'https://rubygems.org/downloads/tablestyle-0.0.13.gem'," +
+			" 'urlhash', 1, 'MIT', 'tablestyle', (SELECT id FROM versions WHERE version_name = '0.0.13'), 5614)",
+		"UPDATE projects SET license_id = 552 WHERE purl_name = 'electron-debug' AND mine_id = 2",
+		"INSERT INTO all_urls (package_hash, vendor, component, version, date, url, url_hash, mine_id, license, purl_name, version_id, license_id)" +
+			" VALUES ('hash2', 'scanoss', 'new-package', '0.0.13', '2026-01-01', 'https://registry.npmjs.org/new-package/-/new-package-0.0.13.tgz'," +
+			" 'urlhash2', 2, 'MIT', 'new-package', (SELECT id FROM versions WHERE version_name = '0.0.13'), 5614)",
+	}
+	for _, stmt := range changes {
+		if _, err = newDB.Exec(stmt); err != nil {
+			t.Fatalf("failed to apply change %q: %v", stmt, err)
+		}
+	}
+
+	service := NewDiffService(models.NewModels(oldDB), models.NewModels(newDB))
+	diff, err := service.Diff(ctx)
+	if err != nil {
+		t.Fatalf("Diff() error = %v", err)
+	}
+	fmt.Printf("Diff: %#v\n", diff)
+
+	if diff.OldRelease != "" || diff.NewRelease != "2026.01" {
+		t.Errorf("Diff() unexpected releases: %v -> %v", diff.OldRelease, diff.NewRelease)
+	}
+	if !slices.Equal(diff.AddedProjects, []string{"pkg:npm/new-package"}) {
+		t.Errorf("Diff() unexpected added projects: %v", diff.AddedProjects)
+	}
+	if !slices.Equal(diff.RemovedProjects, []string{"pkg:npm/node-blob"}) {
+		t.Errorf("Diff() unexpected removed projects: %v", diff.RemovedProjects)
+	}
+	if len(diff.NewVersions) != 2 || diff.NewVersions[0].Purl != "pkg:gem/tablestyle" || !slices.Equal(diff.NewVersions[0].Versions, []string{"0.0.13"}) ||
+		diff.NewVersions[1].Purl != "pkg:npm/new-package" || !slices.Equal(diff.NewVersions[1].Versions, []string{"0.0.13"}) {
+		t.Errorf("Diff() unexpected new versions: %v", diff.NewVersions)
+	}
+	if len(diff.LicenseChanges) != 1 || diff.LicenseChanges[0].Purl != "pkg:npm/electron-debug" ||
+		!slices.Equal(diff.LicenseChanges[0].NewLicenses, []string{"Apache 2.0"}) {
+		t.Errorf("Diff() unexpected license changes: %v", diff.LicenseChanges)
+	}
+}
+
+func TestDiffIdentical(t *testing.T) {
+	err := zlog.NewSugaredDevLogger()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a sugared logger", err)
+	}
+	defer zlog.SyncZap()
+	ctx := ctxzap.ToContext(context.Background(), zlog.L)
+	db := testutils.SqliteSetup(t)
+	defer testutils.CloseDB(t, db)
+	testutils.LoadMockSQLData(t, db, "../../internal/testutils/mock")
+
+	m := models.NewModels(db)
+	diff, err := NewDiffService(m, m).Diff(ctx)
+	if err != nil {
+		t.Fatalf("Diff() error = %v", err)
+	}
+	if len(diff.AddedProjects)+len(diff.RemovedProjects)+len(diff.NewVersions)+len(diff.LicenseChanges) > 0 {
+		t.Errorf("Diff() expected no changes, got %#v", diff)
+	}
+}
+
+func TestDiffCancelled(t *testing.T) {
+	err := zlog.NewSugaredDevLogger()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a sugared logger", err)
+	}
+	defer zlog.SyncZap()
+	ctx, cancel := context.WithCancel(ctxzap.ToContext(context.Background(), zlog.L))
+	db := testutils.SqliteSetup(t)
+	defer testutils.CloseDB(t, db)
+	testutils.LoadMockSQLData(t, db, "../../internal/testutils/mock")
+
+	m := models.NewModels(db)
+	cancel()
+	_, err = NewDiffService(m, m).Diff(ctx)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Diff() expected context.Canceled, got %v", err)
+	}
+}
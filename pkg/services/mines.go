@@ -0,0 +1,169 @@
+// SPDX-License-Identifier: GPL-2.0-or-later
+/*
+ * Copyright (C) 2026 SCANOSS.COM
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 2 of the License, or
+ * (at your option) any later version.
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
+	"github.com/scanoss/go-models/pkg/models"
+)
+
+// MinePriority lists, per purl type, the names of the preferred mines (most preferred first).
+// Mines of the purl type that are not listed rank after the listed ones.
+type MinePriority map[string][]string
+
+// DefaultMinePriority returns a suggested mine priority for purl types shared by several mines,
+// to be enabled with SetMinePriority.
+func DefaultMinePriority() MinePriority {
+	return MinePriority{
+		"rpm":   {"fedoraproject.org", "centos.org", "opensuse.org", "rpmfusion.org", "rpmfind.net"},
+		"deb":   {"debian.org", "launchpad.net"},
+		"maven": {"maven.org", "spring.io"},
+	}
+}
+
+// distroMines maps distro qualifier names to the mine their packages are collected from.
+var distroMines = map[string]string{
+	"fedora":   "fedoraproject.org",
+	"centos":   "centos.org",
+	"opensuse": "opensuse.org",
+	"suse":     "opensuse.org",
+	"debian":   "debian.org",
+	"ubuntu":   "launchpad.net",
+}
+
+// SetMinePriority sets the mine priority used to rank mines sharing a purl type, resolving them from the first mine
+// with a matching version. A nil priority (the default) merges the results of every mine. It should be set before the service is used.
+func (cs *ComponentService) SetMinePriority(priority MinePriority) {
+	cs.minePriority = priority
+}
+
+// rankedMines returns the mines to query in turn for a component, restricted by its distro or repository_url
+// qualifiers and ordered by the mine priority. Returns nil if the results of every mine should be merged instead:
+// the options already select a mine, or the purl type has a single mine and no qualifiers apply.
+func (cs *ComponentService) rankedMines(ctx context.Context, purlType string, qualifiers map[string]string, opts models.URLQueryOptions) ([]models.Mine, error) {
+	if len(opts.MineIDs) > 0 || len(opts.MineName) > 0 {
+		return nil, nil
+	}
+	priority := cs.minePriority[purlType]
+	if !hasMineQualifiers(qualifiers) && len(priority) == 0 {
+		return nil, nil
+	}
+	mines, restricted, err := cs.purlMines(ctx, purlType, qualifiers)
+	if errors.Is(err, models.ErrNotFound) {
+		return nil, nil // leave it to the all_urls lookup to report the component as not found
+	}
+	if err != nil {
+		return nil, err
+	}
+	if restricted && len(mines) == 0 {
+		return nil, fmt.Errorf("%w: no %s mine matching the purl qualifiers %v", models.ErrNotFound, purlType, qualifiers)
+	}
+	if !restricted && len(mines) < 2 {
+		return nil, nil
+	}
+	rank := make(map[string]int, len(priority))
+	for i, name := range priority {
+		rank[name] = i + 1
+	}
+	sort.SliceStable(mines, func(i, j int) bool { // listed mines first, then the rest in ID order
+		ri, rj := rank[mines[i].Name], rank[mines[j].Name]
+		return ri > 0 && (rj == 0 || ri < rj)
+	})
+	ctxzap.Extract(ctx).Sugar().Debugf("Ranked %v mines: %v", purlType, mines)
+	return mines, nil
+}
+
+// purlMines returns the mines of a purl type, restricted to those matching its distro and repository_url qualifiers
+// (restricted reports whether any qualifier applied). A repository matching none of the mines (e.g. a mirror) is ignored.
+func (cs *ComponentService) purlMines(ctx context.Context, purlType string, qualifiers map[string]string) ([]models.Mine, bool, error) {
+	if err := cs.models.Schema.Check(models.ModelMines); err != nil {
+		return nil, false, err
+	}
+	mines, err := cs.models.Mines.GetMinesByPurlType(ctx, purlType)
+	if err != nil {
+		return nil, false, err
+	}
+	restricted := false
+	if distro := qualifiers[QualifierDistro]; len(distro) > 0 {
+		mines, restricted = filterMines(mines, distroMine(distro)), true
+	}
+	if repositoryURL := qualifiers[QualifierRepositoryURL]; len(repositoryURL) > 0 {
+		if filtered := filterMines(mines, repositoryHost(repositoryURL)); len(filtered) > 0 {
+			mines, restricted = filtered, true
+		} else {
+			ctxzap.Extract(ctx).Sugar().Debugf("Ignoring repository %v not matching any %v mine", repositoryURL, purlType)
+		}
+	}
+	return mines, restricted, nil
+}
+
+// filterMines returns the mines whose name is the given host, or a parent domain of it.
+func filterMines(mines []models.Mine, host string) []models.Mine {
+	var filtered []models.Mine
+	for _, mine := range mines {
+		if len(mine.Name) > 0 && (host == mine.Name || strings.HasSuffix(host, "."+mine.Name)) {
+			filtered = append(filtered, mine)
+		}
+	}
+	return filtered
+}
+
+// distroMine returns the mine name for a distro qualifier, ignoring any release suffix (e.g. fedora-38 or ubuntu22.04).
+func distroMine(distro string) string {
+	name := strings.ToLower(distro)
+	if i := strings.IndexFunc(name, func(r rune) bool { return r == '-' || r == '_' || (r >= '0' && r <= '9') }); i >= 0 {
+		name = name[:i]
+	}
+	if mine, ok := distroMines[name]; ok {
+		return mine
+	}
+	return name
+}
+
+// repositoryHost returns the lowercase host of a repository_url qualifier, which may omit the scheme.
+func repositoryHost(repositoryURL string) string {
+	raw := strings.ToLower(repositoryURL)
+	if !strings.Contains(raw, "://") {
+		raw = "https://" + raw
+	}
+	u, err := url.Parse(raw)
+	if err != nil {
+		return ""
+	}
+	return u.Hostname()
+}
+
+// mineOptions returns the query options to try in turn: one per ranked mine, or the given options if there are none.
+func mineOptions(opts models.URLQueryOptions, mines []models.Mine) []models.URLQueryOptions {
+	if len(mines) == 0 {
+		return []models.URLQueryOptions{opts}
+	}
+	res := make([]models.URLQueryOptions, 0, len(mines))
+	for _, mine := range mines {
+		mineOpts := opts
+		mineOpts.MineIDs = []int32{mine.ID}
+		res = append(res, mineOpts)
+	}
+	return res
+}
@@ -0,0 +1,128 @@
+// SPDX-License-Identifier: GPL-2.0-or-later
+/*
+ * Copyright (C) 2026 SCANOSS.COM
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 2 of the License, or
+ * (at your option) any later version.
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
+	"github.com/scanoss/go-models/internal/testutils"
+	"github.com/scanoss/go-models/pkg/models"
+	"github.com/scanoss/go-models/pkg/types"
+	zlog "github.com/scanoss/zap-logging-helper/pkg/logger"
+)
+
+func TestGetComponentMinePriority(t *testing.T) {
+	err := zlog.NewSugaredDevLogger()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a sugared logger", err)
+	}
+	defer zlog.SyncZap()
+	ctx := ctxzap.ToContext(context.Background(), zlog.L)
+	db := testutils.SqliteSetup(t)
+	defer testutils.CloseDB(t, db)
+	testutils.LoadMockSQLData(t, db, "../../internal/testutils/mock")
+	testutils.LoadSQLDataFile(t, db, "../../internal/testutils/mock/multi_mine.sql")
+
+	service := NewComponentService(models.NewModels(db))
+	// By default, the versions of every mine are merged
+	result, err := service.GetComponent(ctx, types.ComponentRequest{Purl: "pkg:rpm/curl"})
+	if err != nil || result.Version != "8.0.1" {
+		t.Errorf("expected the highest version of every mine by default, got %v (%v)", result.Version, err)
+	}
+
+	service.SetMinePriority(DefaultMinePriority())
+	tests := []struct {
+		name        string
+		purl        string
+		requirement string
+		opts        models.URLQueryOptions
+		wantVer     string
+		wantURL     string
+		wantErr     error
+	}{
+		{name: "preferred rpm mine", purl: "pkg:rpm/curl", wantVer: "7.76.1", wantURL: "fedoraproject.org"},
+		{name: "falls back to the next mine", purl: "pkg:rpm/curl", requirement: ">=7.77.0", wantVer: "7.79.1", wantURL: "opensuse.org"},
+		{name: "falls back to the lowest priority mine", purl: "pkg:rpm/curl", requirement: ">=8.0.0", wantVer: "8.0.1", wantURL: "rpmfind.net"},
+		{name: "distro qualifier", purl: "pkg:rpm/curl?distro=opensuse-tumbleweed", wantVer: "7.79.1", wantURL: "opensuse.org"},
+		{name: "distro qualifier without a match", purl: "pkg:rpm/curl?distro=opensuse-tumbleweed", requirement: ">=8.0.0", wantErr: models.ErrNoVersionMatch},
+		{name: "unknown distro", purl: "pkg:rpm/curl?distro=plan9", wantErr: models.ErrNotFound},
+		{name: "preferred maven mine", purl: "pkg:maven/org.springframework/spring-core", wantVer: "5.3.9", wantURL: "repo1.maven.org"},
+		{name: "repository qualifier", purl: "pkg:maven/org.springframework/spring-core?repository_url=repo.spring.io/release", wantVer: "5.3.10", wantURL: "repo.spring.io"},
+		{name: "pinned version from another mine", purl: "pkg:maven/org.springframework/spring-core@5.3.10", wantVer: "5.3.10", wantURL: "repo.spring.io"},
+		{name: "mine option overrides the priority", purl: "pkg:rpm/curl", opts: models.URLQueryOptions{MineName: "rpmfind.net"}, wantVer: "8.0.1", wantURL: "rpmfind.net"},
+		{name: "single mine type", purl: "pkg:npm/electron-updater@4.0.8", wantVer: "4.0.8", wantURL: "registry.npmjs.org"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, getErr := service.GetComponentWithOptions(ctx, types.ComponentRequest{Purl: tt.purl, Requirement: tt.requirement}, tt.opts)
+			if tt.wantErr != nil {
+				if !errors.Is(getErr, tt.wantErr) {
+					t.Errorf("expected error %v, got %v", tt.wantErr, getErr)
+				}
+				return
+			}
+			if getErr != nil {
+				t.Fatalf("unexpected error: %v", getErr)
+			}
+			if result.Version != tt.wantVer || !hasHostSuffix(result.DownloadURL, tt.wantURL) {
+				t.Errorf("expected version %v from %v, got %v from %v", tt.wantVer, tt.wantURL, result.Version, result.DownloadURL)
+			}
+		})
+	}
+
+	// Without a priority, the versions of every mine are merged
+	service.SetMinePriority(nil)
+	result, err = service.GetComponent(ctx, types.ComponentRequest{Purl: "pkg:rpm/curl"})
+	if err != nil || result.Version != "8.0.1" {
+		t.Errorf("expected the highest version of every mine, got %v (%v)", result.Version, err)
+	}
+	service.SetMinePriority(MinePriority{"rpm": {"opensuse.org"}})
+	result, err = service.GetComponent(ctx, types.ComponentRequest{Purl: "pkg:rpm/curl"})
+	if err != nil || result.Version != "7.79.1" {
+		t.Errorf("expected the version of the configured mine, got %v (%v)", result.Version, err)
+	}
+	// Mines missing from the priority are tried after the listed ones
+	result, err = service.GetComponent(ctx, types.ComponentRequest{Purl: "pkg:rpm/curl", Requirement: ">=8.0.0"})
+	if err != nil || result.Version != "8.0.1" || !hasHostSuffix(result.DownloadURL, "rpmfind.net") {
+		t.Errorf("expected the version of an unlisted mine, got %v from %v (%v)", result.Version, result.DownloadURL, err)
+	}
+}
+
+func TestDistroMine(t *testing.T) {
+	tests := map[string]string{
+		"fedora-38":    "fedoraproject.org",
+		"Fedora":       "fedoraproject.org",
+		"ubuntu22.04":  "launchpad.net",
+		"debian_12":    "debian.org",
+		"centos-7":     "centos.org",
+		"rpmfind.net":  "rpmfind.net",
+		"unknownlinux": "unknownlinux",
+	}
+	for distro, want := range tests {
+		if got := distroMine(distro); got != want {
+			t.Errorf("distroMine(%v) = %v, want %v", distro, got, want)
+		}
+	}
+}
+
+// hasHostSuffix reports whether the host of the given URL is (or is a subdomain of) the given domain.
+func hasHostSuffix(rawURL, domain string) bool {
+	return len(filterMines([]models.Mine{{Name: domain}}, repositoryHost(rawURL))) > 0
+}
@@ -0,0 +1,46 @@
+// SPDX-License-Identifier: GPL-2.0-or-later
+/*
+ * Copyright (C) 2026 SCANOSS.COM
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 2 of the License, or
+ * (at your option) any later version.
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package services
+
+import (
+	"github.com/scanoss/go-models/pkg/models"
+)
+
+// Purl qualifiers mapped onto lookup filters. Other qualifiers (e.g. arch) are ignored.
+const (
+	QualifierDistro        = "distro"         // Linux distribution (e.g. fedora-38), mapped to the mine it is collected from
+	QualifierRepositoryURL = "repository_url" // Package repository (e.g. repo.spring.io/release), matched against the mine names
+	QualifierType          = "type"           // Artifact file type (e.g. jar or pom), matched against the download URL
+	QualifierClassifier    = "classifier"     // Artifact classifier (e.g. sources), matched against the download URL
+)
+
+// hasMineQualifiers reports whether the qualifiers may restrict the mines of a lookup.
+func hasMineQualifiers(qualifiers map[string]string) bool {
+	return len(qualifiers[QualifierDistro]) > 0 || len(qualifiers[QualifierRepositoryURL]) > 0
+}
+
+// qualifierOptions adds the artifact filters of the type and classifier qualifiers to the query options,
+// unless the options already set them.
+func qualifierOptions(qualifiers map[string]string, opts models.URLQueryOptions) models.URLQueryOptions {
+	if len(opts.FileType) == 0 {
+		opts.FileType = qualifiers[QualifierType]
+	}
+	if len(opts.Classifier) == 0 {
+		opts.Classifier = qualifiers[QualifierClassifier]
+	}
+	return opts
+}
@@ -0,0 +1,115 @@
+// SPDX-License-Identifier: GPL-2.0-or-later
+/*
+ * Copyright (C) 2026 SCANOSS.COM
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 2 of the License, or
+ * (at your option) any later version.
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package services
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
+	"github.com/scanoss/go-models/internal/testutils"
+	"github.com/scanoss/go-models/pkg/models"
+	"github.com/scanoss/go-models/pkg/types"
+	zlog "github.com/scanoss/zap-logging-helper/pkg/logger"
+)
+
+func TestGetComponentQualifiers(t *testing.T) {
+	err := zlog.NewSugaredDevLogger()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a sugared logger", err)
+	}
+	defer zlog.SyncZap()
+	ctx := ctxzap.ToContext(context.Background(), zlog.L)
+	db := testutils.SqliteSetup(t)
+	defer testutils.CloseDB(t, db)
+	testutils.LoadMockSQLData(t, db, "../../internal/testutils/mock")
+	testutils.LoadSQLDataFile(t, db, "../../internal/testutils/mock/multi_mine.sql")
+
+	service := NewComponentService(models.NewModels(db))
+	service.SetMinePriority(DefaultMinePriority())
+	tests := []struct {
+		name       string
+		purl       string
+		opts       models.URLQueryOptions
+		wantVer    string
+		wantURLEnd string
+		wantErr    error
+	}{
+		{name: "classifier", purl: "pkg:maven/org.springframework/spring-core?classifier=sources", wantVer: "5.3.9", wantURLEnd: "spring-core-5.3.9-sources.jar"},
+		{name: "type", purl: "pkg:maven/org.springframework/spring-core?type=jar", wantVer: "5.3.9", wantURLEnd: "spring-core-5.3.9.jar"},
+		{name: "type and repository", purl: "pkg:maven/org.springframework/spring-core?repository_url=repo.spring.io/release&type=jar", wantVer: "5.3.10", wantURLEnd: "spring-core-5.3.10.jar"},
+		{name: "type without artifacts", purl: "pkg:maven/org.springframework/spring-core?type=pom", wantErr: models.ErrNotFound},
+		{name: "options override qualifiers", purl: "pkg:maven/org.springframework/spring-core?type=pom", opts: models.URLQueryOptions{FileType: "jar"}, wantVer: "5.3.9"},
+		{name: "subpath", purl: "pkg:npm/electron-updater@4.0.8#out/main.js", wantErr: models.ErrInvalidInput},
+		{name: "repository mirror", purl: "pkg:npm/electron-updater@4.0.8?repository_url=registry.yarnpkg.com", wantVer: "4.0.8"},
+		{name: "unrelated qualifier", purl: "pkg:rpm/curl?arch=x86_64&distro=fedora-34", wantVer: "7.76.1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, getErr := service.GetComponentWithOptions(ctx, types.ComponentRequest{Purl: tt.purl}, tt.opts)
+			if tt.wantErr != nil {
+				if !errors.Is(getErr, tt.wantErr) {
+					t.Errorf("expected error %v, got %v", tt.wantErr, getErr)
+				}
+				return
+			}
+			if getErr != nil {
+				t.Fatalf("unexpected error: %v", getErr)
+			}
+			if result.Version != tt.wantVer || !strings.HasSuffix(result.DownloadURL, tt.wantURLEnd) {
+				t.Errorf("expected version %v (%v), got %v (%v)", tt.wantVer, tt.wantURLEnd, result.Version, result.DownloadURL)
+			}
+			if result.Purl != tt.purl {
+				t.Errorf("expected the request purl %v to be preserved, got %v", tt.purl, result.Purl)
+			}
+		})
+	}
+}
+
+func TestCheckPurlQualifiers(t *testing.T) {
+	err := zlog.NewSugaredDevLogger()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a sugared logger", err)
+	}
+	defer zlog.SyncZap()
+	ctx := ctxzap.ToContext(context.Background(), zlog.L)
+	db := testutils.SqliteSetup(t)
+	defer testutils.CloseDB(t, db)
+	testutils.LoadMockSQLData(t, db, "../../internal/testutils/mock")
+	testutils.LoadSQLDataFile(t, db, "../../internal/testutils/mock/multi_mine.sql")
+
+	service := NewComponentService(models.NewModels(db))
+	tests := map[string]int{
+		"pkg:rpm/curl":                                   2,
+		"pkg:rpm/curl?distro=fedora-34":                  1,
+		"pkg:rpm/curl?distro=opensuse-tumbleweed#SPECS":  1,
+		"pkg:rpm/curl?distro=ubuntu-22.04":               0,
+		"pkg:rpm/curl?repository_url=mirror.example.com": 2,
+		"pkg:gem/tablestyle?type=gem":                    1,
+		"pkg:gem/tablestyle?type=jar":                    0,
+		"pkg:rpm/curl?distro=fedora-34&type=rpm":         1,
+		"pkg:rpm/curl?classifier=sources":                0,
+	}
+	for purl, want := range tests {
+		count, checkErr := service.CheckPurl(ctx, purl)
+		if checkErr != nil || count != want {
+			t.Errorf("CheckPurl(%v) = %v, %v, want %v", purl, count, checkErr, want)
+		}
+	}
+}
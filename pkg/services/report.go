@@ -0,0 +1,199 @@
+// SPDX-License-Identifier: GPL-2.0-or-later
+/*
+ * Copyright (C) 2026 SCANOSS.COM
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 2 of the License, or
+ * (at your option) any later version.
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package services
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
+	"github.com/scanoss/go-models/pkg/manifest"
+	"github.com/scanoss/go-models/pkg/models"
+	"github.com/scanoss/go-models/pkg/types"
+	purlutils "github.com/scanoss/go-purl-helper/pkg"
+)
+
+// latestRequirement matches any stable release, so pre-releases are only reported as latest if there is nothing else.
+const latestRequirement = "*"
+
+// ReportService builds dependency resolution reports using the ComponentService.
+type ReportService struct {
+	component *ComponentService
+}
+
+// NewReportService creates a new ReportService instance on top of the given component service.
+func NewReportService(component *ComponentService) *ReportService {
+	return &ReportService{
+		component: component,
+	}
+}
+
+// Report resolves each component request (without following transitive dependencies), reporting the resolved
+// and latest versions, and the licenses of the resolved version.
+// Individual failures are reported per dependency, while an unsupported database schema fails the whole report.
+func (rs *ReportService) Report(ctx context.Context, reqs []types.ComponentRequest) (types.DependencyReport, error) {
+	report := types.DependencyReport{Dependencies: make([]types.DependencyResult, 0, len(reqs))}
+	for _, req := range reqs {
+		if err := ctx.Err(); err != nil {
+			return types.DependencyReport{}, err
+		}
+		result, err := rs.resolve(ctx, req)
+		if err != nil {
+			return types.DependencyReport{}, err
+		}
+		report.Dependencies = append(report.Dependencies, result)
+		report.Summary.Total++
+		switch result.Status {
+		case types.DependencyResolved:
+			report.Summary.Resolved++
+		case types.DependencyUnsatisfiable:
+			report.Summary.Unsatisfiable++
+		case types.DependencyNotFound:
+			report.Summary.NotFound++
+		default:
+			report.Summary.Failed++
+		}
+		if result.Outdated {
+			report.Summary.Outdated++
+		}
+	}
+	return report, nil
+}
+
+// ReportManifest parses the given manifest file (see manifest.Parse) and reports on its dependencies.
+func (rs *ReportService) ReportManifest(ctx context.Context, filename string, r io.Reader) (types.DependencyReport, error) {
+	reqs, err := manifest.Parse(filename, r)
+	if err != nil {
+		return types.DependencyReport{}, err
+	}
+	return rs.Report(ctx, reqs)
+}
+
+// resolve resolves a single dependency. Only errors that apply to every dependency are returned.
+func (rs *ReportService) resolve(ctx context.Context, req types.ComponentRequest) (types.DependencyResult, error) {
+	s := ctxzap.Extract(ctx).Sugar()
+	result := types.DependencyResult{Purl: req.Purl, Requirement: req.Requirement}
+	latest, latestErr := rs.latest(ctx, req.Purl, req.AsOf)
+	if errors.Is(latestErr, models.ErrSchemaIncompatible) {
+		return result, latestErr
+	}
+	result.LatestVersion = latest.Version
+	if err := requirementError(req.Requirement); err != nil {
+		s.Debugf("Failed to resolve %v: %v", req.Purl, err)
+		result.Status = types.DependencyFailed
+		result.Error = err.Error()
+		return result, nil
+	}
+
+	res, err := rs.component.GetComponent(ctx, req)
+	switch {
+	case err == nil:
+		result.Status = types.DependencyResolved
+		result.Satisfiable = true
+		result.Version = res.Version
+		result.Licenses = res.Licenses
+		result.Outdated = isOlder(res.Version, latest.Version)
+		return result, nil
+	case errors.Is(err, models.ErrInvalidInput), errors.Is(err, models.ErrDatabase):
+		result.Status = types.DependencyFailed
+	case errors.Is(err, models.ErrNoVersionMatch), latestErr == nil:
+		result.Status = types.DependencyUnsatisfiable // the component exists, so no version matched
+	case errors.Is(err, models.ErrNotFound):
+		result.Status = types.DependencyNotFound
+	default:
+		result.Status = types.DependencyFailed
+	}
+	s.Debugf("Failed to resolve %v (%v): %v", req.Purl, req.Requirement, err)
+	result.Error = err.Error()
+	return result, nil
+}
+
+// requirementError returns an error if the requirement is neither an exact version nor a valid semver constraint.
+// GetComponent ignores such requirements, which would otherwise report the latest version as resolved.
+func requirementError(requirement string) error {
+	if len(requirement) == 0 || len(purlutils.GetVersionFromReq(requirement)) > 0 {
+		return nil
+	}
+	if _, err := semver.NewConstraint(requirement); err != nil {
+		return fmt.Errorf("%w: invalid requirement %q: %w", models.ErrInvalidInput, requirement, err)
+	}
+	return nil
+}
+
+// latest returns the latest version of the given purl (ignoring any version it contains), as of the given date if set.
+func (rs *ReportService) latest(ctx context.Context, p, asOf string) (types.ComponentResponse, error) {
+	purl, err := purlutils.PurlFromString(p)
+	if err != nil {
+		return types.ComponentResponse{}, fmt.Errorf("%w: failed to parse purl: %w", models.ErrInvalidInput, err)
+	}
+	purl.Version = ""
+	res, err := rs.component.GetComponent(ctx, types.ComponentRequest{Purl: purl.ToString(), Requirement: latestRequirement, AsOf: asOf})
+	if errors.Is(err, models.ErrNoVersionMatch) { // only pre-releases available
+		res, err = rs.component.GetComponent(ctx, types.ComponentRequest{Purl: purl.ToString(), AsOf: asOf})
+	}
+	return res, err
+}
+
+// isOlder reports whether version is older than latest (both must be valid semantic versions).
+func isOlder(version, latest string) bool {
+	v, err := semver.NewVersion(version)
+	if err != nil {
+		return false
+	}
+	l, err := semver.NewVersion(latest)
+	if err != nil {
+		return false
+	}
+	return v.LessThan(l)
+}
+
+// WriteReportJSON writes a dependency report as indented JSON.
+func WriteReportJSON(w io.Writer, report types.DependencyReport) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(report)
+}
+
+// WriteReportCSV writes the dependencies of a report as CSV, with a header row.
+// Licenses are listed by SPDX ID (or name if there is none), separated by semicolons.
+func WriteReportCSV(w io.Writer, report types.DependencyReport) error {
+	cw := csv.NewWriter(w)
+	_ = cw.Write([]string{"purl", "requirement", "version", "satisfiable", "latest_version", "outdated", "licenses", "status", "error"})
+	for _, d := range report.Dependencies {
+		licenses := make([]string, 0, len(d.Licenses))
+		for _, l := range d.Licenses {
+			if len(l.SPDXID) > 0 {
+				licenses = append(licenses, l.SPDXID)
+			} else {
+				licenses = append(licenses, l.Name)
+			}
+		}
+		_ = cw.Write([]string{
+			d.Purl, d.Requirement, d.Version, strconv.FormatBool(d.Satisfiable), d.LatestVersion,
+			strconv.FormatBool(d.Outdated), strings.Join(licenses, ";"), d.Status, d.Error,
+		})
+	}
+	cw.Flush()
+	return cw.Error()
+}
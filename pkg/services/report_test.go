@@ -0,0 +1,137 @@
+// SPDX-License-Identifier: GPL-2.0-or-later
+/*
+ * Copyright (C) 2026 SCANOSS.COM
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 2 of the License, or
+ * (at your option) any later version.
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
+	"github.com/scanoss/go-models/internal/testutils"
+	"github.com/scanoss/go-models/pkg/manifest"
+	"github.com/scanoss/go-models/pkg/models"
+	"github.com/scanoss/go-models/pkg/types"
+	zlog "github.com/scanoss/zap-logging-helper/pkg/logger"
+)
+
+func TestReport(t *testing.T) {
+	err := zlog.NewSugaredDevLogger()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a sugared logger", err)
+	}
+	defer zlog.SyncZap()
+	ctx := ctxzap.ToContext(context.Background(), zlog.L)
+	db := testutils.SqliteSetup(t)
+	defer testutils.CloseDB(t, db)
+	testutils.LoadMockSQLData(t, db, "../../internal/testutils/mock")
+
+	service := NewReportService(NewComponentService(models.NewModels(db)))
+	report, err := service.Report(ctx, []types.ComponentRequest{
+		{Purl: "pkg:npm/react", Requirement: "^15.0.0"},
+		{Purl: "pkg:npm/electron-updater@4.0.8"},
+		{Purl: "pkg:gem/tablestyle", Requirement: ">=99.0.0"},
+		{Purl: "pkg:npm/does-not-exist-anywhere"},
+		{Purl: "invalid-purl"},
+		{Purl: "pkg:npm/react", Requirement: ">=not.a.version"},
+	})
+	if err != nil {
+		t.Fatalf("Report() unexpected error: %v", err)
+	}
+	t.Logf("Report: %+v", report)
+	want := types.ReportSummary{Total: 6, Resolved: 2, Unsatisfiable: 1, NotFound: 1, Failed: 2, Outdated: 2}
+	if report.Summary != want {
+		t.Errorf("Report() summary = %+v, want %+v", report.Summary, want)
+	}
+	react := report.Dependencies[0]
+	if react.Status != types.DependencyResolved || !react.Satisfiable || react.Version != "15.7.0" || react.Requirement != "^15.0.0" {
+		t.Errorf("Report() unexpected react result: %+v", react)
+	}
+	if react.LatestVersion != "17.0.2" || !react.Outdated {
+		t.Errorf("Report() react latest = %v, outdated = %v, want 17.0.2 and outdated", react.LatestVersion, react.Outdated)
+	}
+	if len(react.Licenses) != 1 || react.Licenses[0].SPDXID != "MIT" {
+		t.Errorf("Report() react licenses = %+v, want MIT", react.Licenses)
+	}
+	if tablestyle := report.Dependencies[2]; tablestyle.Status != types.DependencyUnsatisfiable || tablestyle.Satisfiable ||
+		len(tablestyle.LatestVersion) == 0 || len(tablestyle.Error) == 0 {
+		t.Errorf("Report() unexpected tablestyle result: %+v", tablestyle)
+	}
+	if missing := report.Dependencies[3]; missing.Status != types.DependencyNotFound || len(missing.LatestVersion) > 0 {
+		t.Errorf("Report() unexpected missing result: %+v", missing)
+	}
+	if invalid := report.Dependencies[4]; invalid.Status != types.DependencyFailed {
+		t.Errorf("Report() unexpected invalid result: %+v", invalid)
+	}
+	if badReq := report.Dependencies[5]; badReq.Status != types.DependencyFailed || badReq.Satisfiable || len(badReq.Version) > 0 ||
+		!strings.Contains(badReq.Error, "invalid requirement") {
+		t.Errorf("Report() unexpected unparsable requirement result: %+v", badReq)
+	}
+
+	var buf bytes.Buffer
+	if err = WriteReportCSV(&buf, report); err != nil {
+		t.Fatalf("WriteReportCSV() unexpected error: %v", err)
+	}
+	records, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatalf("failed to read CSV report: %v", err)
+	}
+	if len(records) != 7 || records[0][0] != "purl" || records[1][2] != "15.7.0" || records[1][6] != "MIT" || records[3][7] != types.DependencyUnsatisfiable {
+		t.Errorf("WriteReportCSV() unexpected records: %v", records)
+	}
+	buf.Reset()
+	if err = WriteReportJSON(&buf, report); err != nil {
+		t.Fatalf("WriteReportJSON() unexpected error: %v", err)
+	}
+	var decoded types.DependencyReport
+	if err = json.Unmarshal(buf.Bytes(), &decoded); err != nil || decoded.Summary != report.Summary {
+		t.Errorf("WriteReportJSON() did not round trip the report: %v, %v", err, buf.String())
+	}
+}
+
+func TestReportManifest(t *testing.T) {
+	err := zlog.NewSugaredDevLogger()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a sugared logger", err)
+	}
+	defer zlog.SyncZap()
+	ctx := ctxzap.ToContext(context.Background(), zlog.L)
+	db := testutils.SqliteSetup(t)
+	defer testutils.CloseDB(t, db)
+	testutils.LoadMockSQLData(t, db, "../../internal/testutils/mock")
+
+	service := NewReportService(NewComponentService(models.NewModels(db)))
+	report, err := service.ReportManifest(ctx, "requirements.txt", strings.NewReader("requests>=2.0\nbinaryornot\n"))
+	if err != nil {
+		t.Fatalf("ReportManifest() unexpected error: %v", err)
+	}
+	if report.Summary.Total != 2 || report.Summary.Resolved != 2 {
+		t.Errorf("ReportManifest() summary = %+v, want 2 resolved", report.Summary)
+	}
+	if _, err = service.ReportManifest(ctx, "unknown.lock", strings.NewReader("")); !errors.Is(err, manifest.ErrUnsupportedManifest) {
+		t.Errorf("ReportManifest() expected ErrUnsupportedManifest, got %v", err)
+	}
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	if _, err = service.Report(cancelled, []types.ComponentRequest{{Purl: "pkg:npm/react"}}); err == nil {
+		t.Errorf("Report() expected an error for a cancelled context")
+	}
+}
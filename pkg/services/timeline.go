@@ -0,0 +1,154 @@
+// SPDX-License-Identifier: GPL-2.0-or-later
+/*
+ * Copyright (C) 2026 SCANOSS.COM
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 2 of the License, or
+ * (at your option) any later version.
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package services
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
+	"github.com/scanoss/go-models/pkg/models"
+	"github.com/scanoss/go-models/pkg/types"
+	purlutils "github.com/scanoss/go-purl-helper/pkg"
+)
+
+// datedRelease is a release being placed on a timeline.
+type datedRelease struct {
+	release types.Release
+	day     time.Time
+	version *semver.Version
+}
+
+// GetReleaseTimeline returns the releases of a component (with a known release date) in chronological order,
+// along with its release cadence. The versions can be narrowed down using the request requirement,
+// while AsOf excludes releases after that date and ages the last release as of it.
+func (cs *ComponentService) GetReleaseTimeline(ctx context.Context, req types.ComponentRequest) (types.ReleaseTimeline, error) {
+	if err := cs.models.Schema.Check(models.ModelAllUrls); err != nil {
+		return types.ReleaseTimeline{}, err
+	}
+	s := ctxzap.Extract(ctx).Sugar()
+	if len(req.Purl) == 0 {
+		return types.ReleaseTimeline{}, fmt.Errorf("%w: please specify a valid purl to query", models.ErrInvalidInput)
+	}
+	purl, err := purlutils.PurlFromString(req.Purl)
+	if err != nil {
+		return types.ReleaseTimeline{}, fmt.Errorf("%w: failed to parse purl: %w", models.ErrInvalidInput, err)
+	}
+	purlName, err := purlutils.PurlNameFromString(req.Purl)
+	if err != nil {
+		return types.ReleaseTimeline{}, fmt.Errorf("%w: failed to extract purl name: %w", models.ErrInvalidInput, err)
+	}
+	if len(purl.Version) > 0 {
+		return types.ReleaseTimeline{}, fmt.Errorf("%w: cannot specify a version for a release timeline", models.ErrInvalidInput)
+	}
+	asOf, err := time.Parse(asOfLayout, cs.now().UTC().Format(asOfLayout))
+	if err != nil {
+		return types.ReleaseTimeline{}, err
+	}
+	if len(req.AsOf) > 0 {
+		if asOf, err = time.Parse(asOfLayout, req.AsOf); err != nil {
+			return types.ReleaseTimeline{}, fmt.Errorf("%w: invalid as of date, expected YYYY-MM-DD: %w", models.ErrInvalidInput, err)
+		}
+	}
+	var c *semver.Constraints
+	if len(req.Requirement) > 0 {
+		if c, err = semver.NewConstraint(req.Requirement); err != nil {
+			return types.ReleaseTimeline{}, fmt.Errorf("%w: invalid requirement %s: %w", models.ErrInvalidInput, req.Requirement, err)
+		}
+	}
+
+	versions, err := cs.models.AllUrls.GetURLVersionsByPurlNameType(ctx, purlName, purl.Type)
+	if err != nil {
+		return types.ReleaseTimeline{}, err
+	}
+	if len(versions) == 0 {
+		return types.ReleaseTimeline{}, fmt.Errorf("%w: cannot find versions for purl %s", models.ErrNotFound, req.Purl)
+	}
+
+	res := types.ReleaseTimeline{Purl: req.Purl, Releases: []types.Release{}}
+	var releases []datedRelease
+	for _, ver := range versions {
+		if len(ver.Version) == 0 && len(ver.SemVer) == 0 {
+			continue
+		}
+		v := parseVersion(ctx, ver)
+		if c != nil && !c.Check(v) {
+			continue
+		}
+		day, dateErr := time.Parse(asOfLayout, releaseDay(ver.EarliestDate))
+		if dateErr != nil {
+			s.Debugf("Leaving version %v without a valid release date out of the timeline: '%v'", ver.Version, ver.EarliestDate)
+			res.Stats.UndatedVersions++
+			continue
+		}
+		if day.After(asOf) {
+			continue
+		}
+		releases = append(releases, datedRelease{release: types.Release{Version: ver.Version, Date: day.Format(asOfLayout)}, day: day, version: v})
+	}
+	if len(releases) == 0 && res.Stats.UndatedVersions == 0 {
+		return types.ReleaseTimeline{}, fmt.Errorf("%w: cannot find versions for purl %s matching the request", models.ErrNoVersionMatch, req.Purl)
+	}
+
+	sort.SliceStable(releases, func(i, j int) bool {
+		if !releases[i].day.Equal(releases[j].day) {
+			return releases[i].day.Before(releases[j].day)
+		}
+		return releases[i].version.LessThan(releases[j].version)
+	})
+	intervals := make([]int, 0, len(releases))
+	for i, r := range releases {
+		if i > 0 {
+			days := daysBetween(releases[i-1].day, r.day)
+			r.release.DaysSincePrevious = &days
+			intervals = append(intervals, days)
+		}
+		res.Releases = append(res.Releases, r.release)
+	}
+	res.Stats.Releases = len(releases)
+	if len(releases) > 0 {
+		first, last := releases[0], releases[len(releases)-1]
+		res.Stats.FirstRelease = first.release.Date
+		res.Stats.LastRelease = last.release.Date
+		res.Stats.LastReleaseAgeDays = daysBetween(last.day, asOf)
+	}
+	res.Stats.MedianIntervalDays = median(intervals)
+	s.Debugf("Found %v releases (%v undated) for %v", res.Stats.Releases, res.Stats.UndatedVersions, req.Purl)
+	return res, nil
+}
+
+// daysBetween returns the number of whole days from one date to another.
+func daysBetween(from, to time.Time) int {
+	return int(to.Sub(from).Hours() / 24)
+}
+
+// median returns the median of the given values (0 if there are none).
+func median(values []int) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	sorted := append([]int(nil), values...)
+	sort.Ints(sorted)
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 1 {
+		return float64(sorted[mid])
+	}
+	return float64(sorted[mid-1]+sorted[mid]) / 2
+}
@@ -0,0 +1,116 @@
+// SPDX-License-Identifier: GPL-2.0-or-later
+/*
+ * Copyright (C) 2026 SCANOSS.COM
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 2 of the License, or
+ * (at your option) any later version.
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
+	"github.com/scanoss/go-models/internal/testutils"
+	"github.com/scanoss/go-models/pkg/models"
+	"github.com/scanoss/go-models/pkg/types"
+	zlog "github.com/scanoss/zap-logging-helper/pkg/logger"
+)
+
+func TestGetReleaseTimeline(t *testing.T) {
+	err := zlog.NewSugaredDevLogger()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a sugared logger", err)
+	}
+	defer zlog.SyncZap()
+	ctx := ctxzap.ToContext(context.Background(), zlog.L)
+	db := testutils.SqliteSetup(t)
+	defer testutils.CloseDB(t, db)
+	testutils.LoadMockSQLData(t, db, "../../internal/testutils/mock")
+
+	service := NewComponentService(models.NewModels(db))
+	service.now = func() time.Time { return time.Date(2022, 1, 1, 15, 0, 0, 0, time.UTC) }
+
+	timeline, err := service.GetReleaseTimeline(ctx, types.ComponentRequest{Purl: "pkg:npm/electron-updater", Requirement: "^4.3.0", AsOf: "2021-06-01"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(timeline.Releases) != 10 || timeline.Releases[0].Version != "4.3.0" || timeline.Releases[9].Version != "4.3.9" {
+		t.Fatalf("unexpected releases: %+v", timeline.Releases)
+	}
+	if timeline.Releases[0].DaysSincePrevious != nil || *timeline.Releases[1].DaysSincePrevious != 26 {
+		t.Errorf("unexpected days since previous release: %+v", timeline.Releases[:2])
+	}
+	// 4.3.6 and 4.3.7 were released on the same day
+	if timeline.Releases[6].Version != "4.3.6" || timeline.Releases[7].Version != "4.3.7" || *timeline.Releases[7].DaysSincePrevious != 0 {
+		t.Errorf("unexpected same day releases: %+v", timeline.Releases[6:8])
+	}
+	want := types.ReleaseStats{Releases: 10, FirstRelease: "2020-04-01", LastRelease: "2021-05-11", MedianIntervalDays: 26, LastReleaseAgeDays: 21}
+	if timeline.Stats != want {
+		t.Errorf("unexpected stats %+v, want %+v", timeline.Stats, want)
+	}
+
+	// Aged as of today
+	timeline, err = service.GetReleaseTimeline(ctx, types.ComponentRequest{Purl: "pkg:npm/electron-updater"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if timeline.Stats.Releases != len(timeline.Releases) || timeline.Stats.LastRelease != "2021-12-01" || timeline.Stats.LastReleaseAgeDays != 31 {
+		t.Errorf("unexpected stats: %+v", timeline.Stats)
+	}
+	for i := 1; i < len(timeline.Releases); i++ {
+		if timeline.Releases[i].Date < timeline.Releases[i-1].Date {
+			t.Errorf("releases out of order: %+v, %+v", timeline.Releases[i-1], timeline.Releases[i])
+		}
+	}
+
+	tests := []struct {
+		name    string
+		req     types.ComponentRequest
+		wantErr error
+	}{
+		{name: "empty purl", req: types.ComponentRequest{}, wantErr: models.ErrInvalidInput},
+		{name: "purl with version", req: types.ComponentRequest{Purl: "pkg:npm/electron-updater@4.0.8"}, wantErr: models.ErrInvalidInput},
+		{name: "invalid requirement", req: types.ComponentRequest{Purl: "pkg:npm/electron-updater", Requirement: "not a range"}, wantErr: models.ErrInvalidInput},
+		{name: "invalid as of", req: types.ComponentRequest{Purl: "pkg:npm/electron-updater", AsOf: "2021"}, wantErr: models.ErrInvalidInput},
+		{name: "unknown component", req: types.ComponentRequest{Purl: "pkg:npm/no-such-component"}, wantErr: models.ErrNotFound},
+		{name: "no matching versions", req: types.ComponentRequest{Purl: "pkg:npm/electron-updater", Requirement: "^99.0.0"}, wantErr: models.ErrNoVersionMatch},
+		{name: "before first release", req: types.ComponentRequest{Purl: "pkg:npm/electron-updater", AsOf: "2010-01-01"}, wantErr: models.ErrNoVersionMatch},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, timelineErr := service.GetReleaseTimeline(ctx, tt.req); !errors.Is(timelineErr, tt.wantErr) {
+				t.Errorf("expected error %v, got %v", tt.wantErr, timelineErr)
+			}
+		})
+	}
+}
+
+func TestMedian(t *testing.T) {
+	tests := []struct {
+		values []int
+		want   float64
+	}{
+		{values: nil, want: 0},
+		{values: []int{7}, want: 7},
+		{values: []int{9, 1, 5}, want: 5},
+		{values: []int{10, 1, 4, 2}, want: 3},
+	}
+	for _, tt := range tests {
+		if got := median(tt.values); got != tt.want {
+			t.Errorf("median(%v) = %v, want %v", tt.values, got, tt.want)
+		}
+	}
+}
@@ -32,6 +32,9 @@ type ComponentRequest struct {
 
 	// Requirement specifies version constraints (e.g., ">=1.0.0", "^2.0.0").
 	Requirement string `json:"requirement"`
+
+	// AsOf optionally resolves the version as of a date (YYYY-MM-DD), excluding versions released after it.
+	AsOf string `json:"as_of,omitempty"`
 }
 
 // ComponentResponse represents the response containing component information.
@@ -41,4 +44,268 @@ type ComponentResponse struct {
 
 	// Version is the component version.
 	Version string `json:"version"`
+
+	// URL is the project URL of the component (if known).
+	URL string `json:"url,omitempty"`
+
+	// Licenses lists the licenses declared for the selected version (if any).
+	Licenses []License `json:"licenses,omitempty"`
+
+	// Vendor is the vendor of the component (if known).
+	Vendor string `json:"vendor,omitempty"`
+
+	// DownloadURL is the download URL of the selected version's package archive.
+	DownloadURL string `json:"download_url,omitempty"`
+
+	// URLHash is the MD5 hash of the download URL.
+	URLHash string `json:"url_hash,omitempty"`
+
+	// PackageHash is the MD5 hash of the package archive.
+	PackageHash string `json:"package_hash,omitempty"`
+
+	// ReleaseDate is the release date of the selected version (YYYY-MM-DD), if known.
+	ReleaseDate string `json:"release_date,omitempty"`
+}
+
+// Dependency resolution statuses reported in DependencyResult.
+const (
+	DependencyResolved      = "resolved"      // a version satisfying the requirement was found
+	DependencyUnsatisfiable = "unsatisfiable" // the component exists, but no version satisfies the requirement
+	DependencyNotFound      = "not_found"     // the component is not in the knowledge base
+	DependencyFailed        = "failed"        // the request is invalid or could not be processed
+)
+
+// DependencyReport represents the resolution of a list of dependencies.
+type DependencyReport struct {
+	// Dependencies lists the resolution of each dependency, in request order.
+	Dependencies []DependencyResult `json:"dependencies"`
+
+	// Summary holds the counts of dependencies per outcome.
+	Summary ReportSummary `json:"summary"`
+}
+
+// DependencyResult represents the resolution of a single dependency.
+type DependencyResult struct {
+	// Purl is the Package URL of the dependency, as requested.
+	Purl string `json:"purl"`
+
+	// Requirement is the declared version requirement (if any).
+	Requirement string `json:"requirement"`
+
+	// Version is the resolved version (if satisfiable).
+	Version string `json:"version"`
+
+	// Satisfiable reports whether a version matching the requirement was found.
+	Satisfiable bool `json:"satisfiable"`
+
+	// LatestVersion is the latest version of the component, preferring stable releases.
+	LatestVersion string `json:"latest_version"`
+
+	// Outdated reports whether the resolved version is older than the latest version.
+	Outdated bool `json:"outdated"`
+
+	// Licenses lists the licenses of the resolved version (if any).
+	Licenses []License `json:"licenses,omitempty"`
+
+	// Status is the resolution status (e.g. "resolved", "unsatisfiable").
+	Status string `json:"status"`
+
+	// Error describes why the dependency could not be resolved (if any).
+	Error string `json:"error,omitempty"`
+}
+
+// ReportSummary represents the counts of dependencies per outcome in a DependencyReport.
+type ReportSummary struct {
+	Total         int `json:"total"`
+	Resolved      int `json:"resolved"`
+	Unsatisfiable int `json:"unsatisfiable"`
+	NotFound      int `json:"not_found"`
+	Failed        int `json:"failed"`
+	Outdated      int `json:"outdated"`
+}
+
+// DatabaseDiff represents the changes between two knowledge base releases.
+type DatabaseDiff struct {
+	// OldRelease is the db_release of the old knowledge base (if known).
+	OldRelease string `json:"old_release"`
+
+	// NewRelease is the db_release of the new knowledge base (if known).
+	NewRelease string `json:"new_release"`
+
+	// AddedProjects lists the purls of projects only present in the new knowledge base.
+	AddedProjects []string `json:"added_projects"`
+
+	// RemovedProjects lists the purls of projects only present in the old knowledge base.
+	RemovedProjects []string `json:"removed_projects"`
+
+	// NewVersions lists the versions added to each project. Every version of an added project is included.
+	NewVersions []VersionChange `json:"new_versions"`
+
+	// LicenseChanges lists the projects whose licenses differ between the knowledge bases.
+	LicenseChanges []LicenseChange `json:"license_changes"`
+}
+
+// VersionChange represents the versions added for a purl between two knowledge base releases.
+type VersionChange struct {
+	// Purl is the Package URL of the component (without version).
+	Purl string `json:"purl"`
+
+	// Versions lists the added versions.
+	Versions []string `json:"versions"`
+}
+
+// LicenseChange represents a change in the licenses of a purl between two knowledge base releases.
+type LicenseChange struct {
+	// Purl is the Package URL of the component (without version).
+	Purl string `json:"purl"`
+
+	// OldLicenses lists the licenses in the old knowledge base.
+	OldLicenses []string `json:"old_licenses"`
+
+	// NewLicenses lists the licenses in the new knowledge base.
+	NewLicenses []string `json:"new_licenses"`
+}
+
+// CheckPurlResponse represents the response to a purl check.
+type CheckPurlResponse struct {
+	// Purl is the Package URL that was checked.
+	Purl string `json:"purl"`
+
+	// Projects is the number of projects matching the purl.
+	Projects int `json:"projects"`
+}
+
+// License represents license information.
+type License struct {
+	// ID is the license identifier in the knowledge base.
+	ID int32 `json:"id"`
+
+	// Name is the license name.
+	Name string `json:"name"`
+
+	// SPDXID is the SPDX identifier of the license (if known).
+	SPDXID string `json:"spdx_id"`
+
+	// IsSpdx reports whether the license has a valid SPDX identifier.
+	IsSpdx bool `json:"is_spdx"`
+}
+
+// Version represents version information.
+type Version struct {
+	// ID is the version identifier in the knowledge base.
+	ID int32 `json:"id"`
+
+	// Name is the version name.
+	Name string `json:"name"`
+
+	// SemVer is the semantic version representation (if any).
+	SemVer string `json:"semver"`
+}
+
+// MinesResponse represents the mines associated with a purl type.
+type MinesResponse struct {
+	// PurlType is the purl type that was queried.
+	PurlType string `json:"purl_type"`
+
+	// MineIDs lists the mine identifiers for the purl type.
+	MineIDs []int32 `json:"mine_ids"`
+}
+
+// DBVersion represents the version information of a knowledge base package.
+type DBVersion struct {
+	// PackageName is the name of the knowledge base package.
+	PackageName string `json:"package_name"`
+
+	// SchemaVersion is the schema version of the package.
+	SchemaVersion string `json:"schema_version"`
+
+	// Release is the knowledge base release (e.g. 2026.01).
+	Release string `json:"db_release"`
+
+	// CreatedAt is the creation time of the package in RFC 3339 format.
+	CreatedAt string `json:"created_at"`
+}
+
+// Hash types reported in ArtifactResponse.
+const (
+	HashTypePackage = "package" // the hash matched the MD5 of the package archive
+	HashTypeURL     = "url"     // the hash matched the MD5 of the download URL
+)
+
+// ArtifactResponse represents the component version identified from an artifact (hash or download URL).
+type ArtifactResponse struct {
+	// Hash is the MD5 hash that was looked up (lowercase), if the lookup was by hash.
+	Hash string `json:"hash,omitempty"`
+
+	// HashType is the kind of hash that matched (package or url), if the lookup was by hash.
+	HashType string `json:"hash_type,omitempty"`
+
+	// Purl is the Package URL of the component, including the version.
+	Purl string `json:"purl"`
+
+	// Version is the component version.
+	Version string `json:"version"`
+
+	// Licenses lists the licenses declared for the version (if any).
+	Licenses []License `json:"licenses,omitempty"`
+
+	// DownloadURL is the download URL of the package archive.
+	DownloadURL string `json:"download_url,omitempty"`
+
+	// ReleaseDate is the release date of the version (YYYY-MM-DD), if known.
+	ReleaseDate string `json:"release_date,omitempty"`
+}
+
+// ReleaseTimeline represents the release history of a component, in chronological order.
+type ReleaseTimeline struct {
+	// Purl is the Package URL of the component.
+	Purl string `json:"purl"`
+
+	// Releases lists the versions with a known release date, oldest first.
+	Releases []Release `json:"releases"`
+
+	// Stats summarises the release cadence.
+	Stats ReleaseStats `json:"stats"`
+}
+
+// Release represents a single version in a ReleaseTimeline.
+type Release struct {
+	// Version is the component version.
+	Version string `json:"version"`
+
+	// Date is the release date of the version (YYYY-MM-DD).
+	Date string `json:"date"`
+
+	// DaysSincePrevious is the number of days since the previous release (nil for the first release).
+	DaysSincePrevious *int `json:"days_since_previous,omitempty"`
+}
+
+// ReleaseStats represents the aggregated release cadence of a component.
+type ReleaseStats struct {
+	// Releases is the number of versions with a known release date.
+	Releases int `json:"releases"`
+
+	// UndatedVersions is the number of versions left out of the timeline as their release date is unknown.
+	UndatedVersions int `json:"undated_versions"`
+
+	// FirstRelease is the date of the first release (YYYY-MM-DD).
+	FirstRelease string `json:"first_release,omitempty"`
+
+	// LastRelease is the date of the last release (YYYY-MM-DD).
+	LastRelease string `json:"last_release,omitempty"`
+
+	// MedianIntervalDays is the median number of days between consecutive releases (0 if fewer than two releases).
+	MedianIntervalDays float64 `json:"median_interval_days"`
+
+	// LastReleaseAgeDays is the number of days since the last release, as of today or the requested date.
+	LastReleaseAgeDays int `json:"last_release_age_days"`
+}
+
+// ErrorResponse represents an error returned by an API.
+type ErrorResponse struct {
+	// Code is a machine-readable error code (e.g. "invalid_request", "not_found").
+	Code string `json:"code"`
+
+	// Message is a human-readable description of the error.
+	Message string `json:"message"`
 }